@@ -0,0 +1,87 @@
+package cachify
+
+import (
+	"context"
+	"time"
+)
+
+// NewBatchLoading creates a new read-through cache that fills misses through a BatchLoader,
+// collecting the misses of concurrent callers into a single backend call.
+//
+// Parameters:
+//   - cache: The in-memory LRU cache holding loaded values.
+//   - loader: The function invoked to fetch the keys missing from the cache, many at once.
+//   - window: How long a batch collects misses after the first one before the loader is called.
+//     Zero or less uses a default of one millisecond.
+//   - maxBatch: The number of keys that triggers the call before the window elapses. Zero or less
+//     means unbounded.
+//
+// Returns:
+//   - A pointer to an initialized Loading cache.
+//
+// Details:
+//   - Each miss waits at most one window, so N concurrent misses cost one call instead of N.
+//   - Keys absent from the returned map fail with ErrNotFound; an error fails every key of the batch.
+//   - The batch runs with the context of the caller that opened it, without its cancellation, so that
+//     one caller giving up does not fail the others; each caller still stops waiting once its own
+//     context is done.
+//   - Everything else behaves as with NewLoading: concurrent misses on the same key join one batch
+//     entry, and retries, stale-if-error and early expiration apply per key.
+func NewBatchLoading(cache *LRU, loader BatchLoader, window time.Duration, maxBatch int) *Loading {
+	if window <= 0 {
+		window = defaultBatchWindow
+	}
+	b := &batcher{cache: cache, loader: loader, window: window, maxBatch: maxBatch}
+	return NewLoading(cache, b.load)
+}
+
+// load adds a key to the pending batch, opening one if needed, and waits for its result.
+func (b *batcher) load(ctx context.Context, key string) (interface{}, error) {
+	b.mutex.Lock()
+	current := b.pending
+	if current == nil {
+		current = &batch{ctx: context.WithoutCancel(ctx), done: make(chan struct{})}
+		b.pending = current
+		time.AfterFunc(b.window, func() { b.flush(current) })
+	}
+	current.keys = append(current.keys, key)
+	full := b.maxBatch > 0 && len(current.keys) >= b.maxBatch
+	b.mutex.Unlock()
+	if full {
+		go b.flush(current)
+	}
+	select {
+	case <-current.done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if current.err != nil {
+		return nil, current.err
+	}
+	value, ok := current.values[key]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return value, nil
+}
+
+// flush calls the loader for a batch unless it has already been flushed.
+func (b *batcher) flush(current *batch) {
+	b.mutex.Lock()
+	if b.pending != current {
+		b.mutex.Unlock()
+		return
+	}
+	b.pending = nil
+	b.mutex.Unlock()
+
+	b.cache.mutex.RLock()
+	onPanic := b.cache.onPanic
+	b.cache.mutex.RUnlock()
+	if panicErr := invoke(&b.cache.stats, onPanic, "load", current.keys[0], func() {
+		current.values, current.err = b.loader(current.ctx, current.keys)
+	}); panicErr != nil {
+		current.values, current.err = nil, panicErr
+	}
+	close(current.done)
+}
@@ -0,0 +1,118 @@
+package cachify
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// WithNegativeFilter remembers keys the Loader reported as absent in a bloom filter, so that repeated
+// lookups of missing keys fail fast instead of reaching the backend.
+//
+// Parameters:
+//   - expected: The number of absent keys the filter is sized for. Zero or less disables the filter.
+//   - falsePositiveRate: The target probability that a key never reported absent is rejected anyway,
+//     e.g. 0.01. Values outside (0, 1) use 0.01.
+//   - reset: How often the filter is emptied so that keys created in the backend become visible again.
+//     Zero or less never empties it.
+//
+// Returns:
+//   - The Loading cache, for chaining.
+//
+// Details:
+//   - A key is added when the Loader fails with an error matching ErrNotFound; afterwards, a miss on
+//     that key returns ErrNotFound without calling the Loader. Nothing is stored in the cache for it.
+//   - A bloom filter cannot forget a single key: a key created in the backend is rejected until the
+//     next reset or ResetNegativeFilter. Keys already cached are always served.
+//   - The filter uses about 1.2 bytes per expected key at a 1% false-positive rate.
+func (l *Loading) WithNegativeFilter(expected int, falsePositiveRate float64, reset time.Duration) *Loading {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.absent = nil
+	if expected > 0 {
+		l.absent = newBloomFilter(expected, falsePositiveRate)
+	}
+	l.absentReset = max(reset, 0)
+	l.absentSince = time.Now()
+	return l
+}
+
+// ResetNegativeFilter forgets every key known to be absent, e.g. after the backend was repopulated.
+func (l *Loading) ResetNegativeFilter() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if l.absent != nil {
+		l.absent.reset()
+		l.absentSince = time.Now()
+	}
+}
+
+// knownAbsent reports whether the negative filter rejects a key, emptying it first if it is due.
+func (l *Loading) knownAbsent(key string) bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if l.absent == nil {
+		return false
+	}
+	if l.absentReset > 0 && time.Since(l.absentSince) >= l.absentReset {
+		l.absent.reset()
+		l.absentSince = time.Now()
+		return false
+	}
+	return l.absent.contains(key)
+}
+
+// markAbsent adds a key to the negative filter when a load reports it does not exist.
+func (l *Loading) markAbsent(key string, err error) {
+	if !errors.Is(err, ErrNotFound) {
+		return
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if l.absent != nil {
+		l.absent.add(key)
+	}
+}
+
+// newBloomFilter creates a bloom filter sized for n keys at the given false-positive rate.
+func newBloomFilter(n int, p float64) *bloomFilter {
+	if p <= 0 || p >= 1 {
+		p = 0.01
+	}
+	m := uint64(math.Ceil(-float64(n) * math.Log(p) / (math.Ln2 * math.Ln2)))
+	m = max((m+63)/64*64, 64)
+	k := uint64(max(math.Round(float64(m)/float64(n)*math.Ln2), 1))
+	return &bloomFilter{bits: make([]uint64, m/64), m: m, k: k}
+}
+
+// add sets the bits of a key.
+func (f *bloomFilter) add(key string) {
+	h1, h2 := f.hashes(key)
+	for i := uint64(0); i < f.k; i++ {
+		bit := (h1 + i*h2) % f.m
+		f.bits[bit/64] |= 1 << (bit % 64)
+	}
+}
+
+// contains reports whether every bit of a key is set, i.e. whether it was probably added.
+func (f *bloomFilter) contains(key string) bool {
+	h1, h2 := f.hashes(key)
+	for i := uint64(0); i < f.k; i++ {
+		bit := (h1 + i*h2) % f.m
+		if f.bits[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// reset clears every bit.
+func (f *bloomFilter) reset() {
+	clear(f.bits)
+}
+
+// hashes derives the two hashes combined into the k bit positions of a key (Kirsch-Mitzenmacher).
+func (f *bloomFilter) hashes(key string) (uint64, uint64) {
+	h := DefaultHasher(key)
+	return h, h>>32 | h<<32 | 1
+}
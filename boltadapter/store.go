@@ -0,0 +1,164 @@
+// Package boltadapter provides an embedded bbolt-backed implementation of cachify.Store,
+// letting a tiered cache spill entries to local disk without an external service.
+package boltadapter
+
+import (
+	"context"
+	"encoding/binary"
+	"time"
+
+	"github.com/pnguyen215/cachify"
+	bolt "go.etcd.io/bbolt"
+)
+
+// headerSize is the number of bytes preceding every encoded value, holding its expiration.
+const headerSize = 8
+
+// Store is a cachify.Store backed by a bbolt database file.
+//
+// Fields:
+//   - db: The bbolt database holding the entries.
+//   - bucket: The name of the bucket the entries are written to.
+//   - codec: The codec used to serialize values.
+type Store struct {
+	db     *bolt.DB
+	bucket []byte
+	codec  cachify.Codec
+}
+
+var _ cachify.Store = (*Store)(nil)
+
+// NewStore creates a new bbolt-backed store, creating the bucket if needed.
+//
+// Parameters:
+//   - db: An open bbolt database. It is not closed by the Store.
+//   - bucket: The bucket name, e.g. "cachify".
+//
+// Returns:
+//   - A pointer to an initialized Store using JSON encoding.
+//   - An error if the bucket cannot be created.
+func NewStore(db *bolt.DB, bucket string) (*Store, error) {
+	s := &Store{
+		db:     db,
+		bucket: []byte(bucket),
+		codec:  cachify.JSONCodec{},
+	}
+	err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(s.bucket)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// WithCodec sets the codec used to serialize values, e.g. cachify.GobCodec{} to preserve Go types.
+//
+// Returns:
+//   - The Store, for chaining.
+func (s *Store) WithCodec(codec cachify.Codec) *Store {
+	s.codec = codec
+	return s
+}
+
+// Get retrieves and decodes the value stored for a key.
+//
+// Details:
+//   - Expired entries are reported as missing; they are removed by the next write to the key or by Purge.
+//   - bbolt transactions cannot be interrupted, so ctx is only checked before the call.
+func (s *Store) Get(ctx context.Context, key string) (value interface{}, ok bool, err error) {
+	if err := ctx.Err(); err != nil {
+		return nil, false, err
+	}
+	var data []byte
+	err = s.db.View(func(tx *bolt.Tx) error {
+		record := tx.Bucket(s.bucket).Get([]byte(key))
+		if record == nil || expired(record, time.Now()) {
+			return nil
+		}
+		// The record is only valid during the transaction
+		data = append([]byte(nil), record[headerSize:]...)
+		return nil
+	})
+	if err != nil || data == nil {
+		return nil, false, err
+	}
+	if err := s.codec.Unmarshal(data, &value); err != nil {
+		return nil, false, err
+	}
+	return value, true, nil
+}
+
+// Set encodes and stores a value for a key.
+//
+// Details:
+//   - Zero ttl means the entry does not expire.
+func (s *Store) Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	data, err := s.codec.Marshal(value)
+	if err != nil {
+		return err
+	}
+	record := make([]byte, headerSize+len(data))
+	if ttl > 0 {
+		binary.BigEndian.PutUint64(record, uint64(time.Now().Add(ttl).UnixNano()))
+	}
+	copy(record[headerSize:], data)
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(s.bucket).Put([]byte(key), record)
+	})
+}
+
+// Delete removes a key from the database.
+func (s *Store) Delete(ctx context.Context, key string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(s.bucket).Delete([]byte(key))
+	})
+}
+
+// Purge removes every expired entry from the bucket.
+//
+// Returns:
+//   - The number of removed entries.
+//   - An error if the transaction failed.
+func (s *Store) Purge() (int, error) {
+	removed := 0
+	now := time.Now()
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(s.bucket)
+		var keys [][]byte
+		// Collect first: deleting while iterating makes the cursor skip items
+		err := bucket.ForEach(func(key, record []byte) error {
+			if expired(record, now) {
+				keys = append(keys, append([]byte(nil), key...))
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, key := range keys {
+			if err := bucket.Delete(key); err != nil {
+				return err
+			}
+		}
+		removed = len(keys)
+		return nil
+	})
+	return removed, err
+}
+
+// expired reports whether a record's expiration header lies in the past.
+func expired(record []byte, now time.Time) bool {
+	if len(record) < headerSize {
+		return true
+	}
+	deadline := int64(binary.BigEndian.Uint64(record))
+	return deadline != 0 && now.UnixNano() > deadline
+}
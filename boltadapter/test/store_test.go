@@ -0,0 +1,51 @@
+package test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/pnguyen215/cachify/boltadapter"
+	"github.com/stretchr/testify/assert"
+	bolt "go.etcd.io/bbolt"
+)
+
+// Test the bbolt store survives reopening and honors TTLs
+func TestBoltStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache.db")
+	db, err := bolt.Open(path, 0600, nil)
+	assert.NoError(t, err)
+	store, err := boltadapter.NewStore(db, "cachify")
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	tiered := cachify.NewTiered(cachify.NewLRU(1), store)
+	tiered.Set("a", "alpha")
+	tiered.Set("b", "beta")
+	assert.NoError(t, store.Set(ctx, "short", "gone", 10*time.Millisecond))
+	assert.NoError(t, db.Close())
+
+	db, err = bolt.Open(path, 0600, nil)
+	assert.NoError(t, err)
+	defer db.Close()
+	store, err = boltadapter.NewStore(db, "cachify")
+	assert.NoError(t, err)
+
+	value, ok := cachify.NewTiered(cachify.NewLRU(1), store).Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, "alpha", value)
+
+	time.Sleep(20 * time.Millisecond)
+	_, ok, err = store.Get(ctx, "short")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	removed, err := store.Purge()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, removed)
+
+	assert.NoError(t, store.Delete(ctx, "b"))
+	_, ok, _ = store.Get(ctx, "b")
+	assert.False(t, ok)
+}
@@ -0,0 +1,146 @@
+package cachify
+
+import (
+	"time"
+)
+
+// WithBufferedAccess makes Get record hits into buffers instead of updating the recency list directly.
+//
+// Parameters:
+//   - size: The number of accesses batched before they are applied. Zero or less uses 64.
+//
+// Returns:
+//   - The LRU cache, for chaining.
+//
+// Details:
+//   - A hit only takes the read lock, so concurrent readers no longer serialize on the write lock.
+//   - Accesses are appended to per-processor batches; a full batch is applied under the write lock
+//     if it is free, and dropped otherwise. Recency, access times, and access counts are therefore
+//     approximate: a hot key may be recorded late or, under heavy contention, not at all.
+//   - Misses, expired entries, and every other operation keep their exact, locked behaviour.
+func (c *LRU) WithBufferedAccess(size int) *LRU {
+	if size <= 0 {
+		size = defaultReadBufferSize
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.reads = newReadBuffer(size)
+	return c
+}
+
+// WithoutBufferedAccess turns buffered access off, making every Get update recency immediately.
+//
+// Returns:
+//   - The LRU cache, for chaining.
+func (c *LRU) WithoutBufferedAccess() *LRU {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.reads = nil
+	return c
+}
+
+// IsBufferedAccess checks whether Get records hits into buffers.
+func (c *LRU) IsBufferedAccess() bool {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.reads != nil
+}
+
+// DroppedAccesses returns the number of buffered accesses discarded because the write lock was busy.
+func (c *LRU) DroppedAccesses() uint64 {
+	c.mutex.RLock()
+	reads := c.reads
+	c.mutex.RUnlock()
+	if reads == nil {
+		return 0
+	}
+	return reads.dropped.Load()
+}
+
+// newReadBuffer creates a read buffer draining batches of the given size.
+func newReadBuffer(size int) *readBuffer {
+	b := &readBuffer{size: size}
+	b.pool.New = func() interface{} {
+		batch := make([]readAccess, 0, size)
+		return &batch
+	}
+	return b
+}
+
+// getBuffered serves a hit under the read lock, recording the access for a later drain.
+//
+// Returns:
+//   - The value and whether the key was found.
+//   - Whether the lookup was served; false means the caller must fall back to the locked path.
+//
+// Details:
+//   - Only hits on live entries are served. Misses are counted here; expired entries fall back
+//     so that the locked path can expire them.
+func (c *LRU) getBuffered(key string) (value interface{}, ok bool, served bool) {
+	c.mutex.RLock()
+	reads := c.reads
+	if reads == nil {
+		c.mutex.RUnlock()
+		return nil, false, false
+	}
+	element, exists := c.cache[key]
+	if !exists {
+		c.mutex.RUnlock()
+		c.stats.miss()
+		c.countNamespaces(key, (*counters).miss)
+		return nil, false, true
+	}
+	entry := element.Value.(*entries)
+	now := time.Now()
+	if expired(entry, now) {
+		c.mutex.RUnlock()
+		return nil, false, false
+	}
+	value = c.view(entry.value)
+	c.mutex.RUnlock()
+	c.stats.hit()
+	c.countNamespaces(key, (*counters).hit)
+	c.record(reads, readAccess{element: element, time: now})
+	return value, true, true
+}
+
+// record appends an access to a batch, draining the batch once it is full.
+//
+// Details:
+//   - Must be called without the lock held.
+//   - The drain only tries the write lock; if it is busy the batch is dropped rather than
+//     making the reader wait, as a lost recency update is cheaper than a stalled Get.
+func (c *LRU) record(reads *readBuffer, access readAccess) {
+	batch := reads.pool.Get().(*[]readAccess)
+	*batch = append(*batch, access)
+	if len(*batch) >= reads.size {
+		if c.mutex.TryLock() {
+			c.drain(*batch)
+			c.mutex.Unlock()
+		} else {
+			reads.dropped.Add(uint64(len(*batch)))
+		}
+		clear(*batch)
+		*batch = (*batch)[:0]
+	}
+	reads.pool.Put(batch)
+}
+
+// drain applies buffered accesses to the recency list.
+//
+// Details:
+//   - Must be called with the write lock held.
+//   - Accesses to elements that have since been removed or replaced are skipped.
+func (c *LRU) drain(batch []readAccess) {
+	for _, access := range batch {
+		entry, ok := c.linked(access.element)
+		if !ok {
+			continue
+		}
+		c.promote(access.element)
+		if access.time.After(entry.accessTime) {
+			entry.accessTime = access.time
+		}
+		entry.accessCount++
+	}
+}
@@ -0,0 +1,62 @@
+package cachify
+
+// GetBytes retrieves the value associated with a binary key.
+//
+// Parameters:
+//   - key: The key bytes, e.g. a binary hash.
+//
+// Returns:
+//   - The value associated with the key, or nil if the key is not found.
+//   - A boolean indicating whether the key exists.
+//
+// Details:
+//   - Equivalent to Get(string(key)) but does not allocate: the conversion happens inside the map lookup.
+func (c *LRU) GetBytes(key []byte) (value interface{}, ok bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	element := c.cache[string(key)]
+	if element == nil && c.namespaces.Load() != nil {
+		c.countNamespaces(string(key), (*counters).miss)
+	}
+	return c.access(element)
+}
+
+// SetBytes inserts or updates a key-value pair with a binary key.
+//
+// Parameters:
+//   - key: The key bytes. They are copied when the key is new, so the caller may reuse the slice.
+//   - value: The value to be associated with the key.
+//
+// Details:
+//   - Equivalent to Set(string(key), value); updating an existing key does not allocate a new key string.
+func (c *LRU) SetBytes(key []byte, value interface{}) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	var k string
+	if element, exists := c.cache[string(key)]; exists {
+		k = element.Value.(*entries).key
+	} else {
+		k = string(key)
+	}
+	c.set(k, value)
+	c.enforceQuotas(k)
+	c.enforceCapacity(c.cache[k])
+}
+
+// ContainsBytes checks whether a binary key exists in the cache without allocating.
+func (c *LRU) ContainsBytes(key []byte) bool {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	_, exists := c.cache[string(key)]
+	return exists
+}
+
+// RemoveBytes deletes a binary key from the cache without allocating.
+func (c *LRU) RemoveBytes(key []byte) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if element, exists := c.cache[string(key)]; exists {
+		c.evict(element)
+	}
+}
@@ -0,0 +1,34 @@
+package cachify
+
+var (
+	_ Cache = (*LRU)(nil)
+	_ Cache = (*Clock)(nil)
+	_ Cache = (*LRUK)(nil)
+	_ Cache = (*Tiered)(nil)
+)
+
+// Close is a no-op kept for the Cache interface; a Clock cache runs no background work.
+func (c *Clock) Close() {}
+
+// Close is a no-op kept for the Cache interface; an LRUK cache runs no background work.
+func (c *LRUK) Close() {}
+
+// Len returns the number of items in the in-memory tier.
+func (t *Tiered) Len() int {
+	return t.l1.Len()
+}
+
+// Contains checks whether a key exists in the in-memory tier, without consulting L2.
+func (t *Tiered) Contains(key string) bool {
+	return t.l1.Contains(key)
+}
+
+// Clear removes every item from the in-memory tier; the backing store is left untouched.
+func (t *Tiered) Clear() {
+	t.l1.Clear()
+}
+
+// Close closes the in-memory tier; the backing store is owned by the caller.
+func (t *Tiered) Close() {
+	t.l1.Close()
+}
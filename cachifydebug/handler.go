@@ -0,0 +1,199 @@
+// Package cachifydebug provides an http.Handler for inspecting the caches registered with cachify.Register.
+//
+// Mount it under a prefix, typically next to net/http/pprof:
+//
+//	http.Handle("/debug/cachify/", http.StripPrefix("/debug/cachify", cachifydebug.NewHandler()))
+//
+// Routes, relative to the mount point:
+//   - GET /: The registered caches and their stats.
+//   - GET /{name}: The stats and hottest keys of a cache.
+//   - GET /{name}/keys/{key}: The value of an entry.
+//   - DELETE /{name}/keys/{key}: Removes an entry.
+package cachifydebug
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pnguyen215/cachify"
+)
+
+// redacted replaces values in responses when redaction is enabled.
+const redacted = "[redacted]"
+
+// defaultHotKeys is the number of hottest keys listed per cache unless overridden with WithHotKeys.
+const defaultHotKeys = 20
+
+// Handler serves the debug endpoints.
+//
+// Fields:
+//   - mux: The router dispatching the endpoints.
+//   - redact: Whether entry values are hidden from responses.
+//   - hotKeys: The number of hottest keys listed per cache.
+type Handler struct {
+	mux     *http.ServeMux
+	redact  bool
+	hotKeys int
+}
+
+// cacheInfo is the JSON description of a registered cache.
+type cacheInfo struct {
+	Name     string        `json:"name"`
+	Stats    cachify.Stats `json:"stats"`
+	Capacity int           `json:"capacity,omitempty"`
+	Hot      []entryInfo   `json:"hot,omitempty"`
+}
+
+// entryInfo is the JSON description of an entry.
+type entryInfo struct {
+	Key         string      `json:"key"`
+	Value       interface{} `json:"value,omitempty"`
+	AccessCount uint64      `json:"access_count,omitempty"`
+	AccessTime  *time.Time  `json:"access_time,omitempty"`
+	Expiration  *time.Time  `json:"expiration,omitempty"`
+}
+
+// NewHandler creates a debug handler over the cachify registry.
+//
+// Returns:
+//   - A pointer to an initialized Handler showing values and the 20 hottest keys per cache.
+func NewHandler() *Handler {
+	h := &Handler{
+		mux:     http.NewServeMux(),
+		hotKeys: defaultHotKeys,
+	}
+	h.mux.HandleFunc("GET /{$}", h.list)
+	h.mux.HandleFunc("GET /{name}", h.describe)
+	h.mux.HandleFunc("GET /{name}/keys/{key...}", h.get)
+	h.mux.HandleFunc("DELETE /{name}/keys/{key...}", h.remove)
+	return h
+}
+
+// WithRedaction hides entry values from every response, for caches holding personal or secret data.
+//
+// Returns:
+//   - The Handler, for chaining.
+func (h *Handler) WithRedaction(redact bool) *Handler {
+	h.redact = redact
+	return h
+}
+
+// WithHotKeys sets the number of hottest keys listed per cache. Zero or less disables the listing.
+//
+// Returns:
+//   - The Handler, for chaining.
+func (h *Handler) WithHotKeys(n int) *Handler {
+	h.hotKeys = n
+	return h
+}
+
+// ServeHTTP dispatches a request to the matching endpoint.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+// list writes every registered cache with its stats.
+func (h *Handler) list(w http.ResponseWriter, r *http.Request) {
+	stats := cachify.RegisteredStats()
+	infos := make([]cacheInfo, 0, len(stats))
+	for _, name := range cachify.RegisteredNames() {
+		if s, ok := stats[name]; ok {
+			infos = append(infos, cacheInfo{Name: name, Stats: s})
+		}
+	}
+	writeJSON(w, http.StatusOK, infos)
+}
+
+// describe writes the stats and hottest keys of a cache.
+func (h *Handler) describe(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	cache, ok := cachify.LookupCache(name)
+	if !ok {
+		http.Error(w, fmt.Sprintf("cache %q not registered", name), http.StatusNotFound)
+		return
+	}
+	info := cacheInfo{Name: name, Stats: cache.Stats()}
+	if lru, ok := cache.(*cachify.LRU); ok && h.hotKeys > 0 {
+		for _, s := range lru.TopN(h.hotKeys) {
+			info.Hot = append(info.Hot, entryInfo{Key: s.Key(), AccessCount: s.AccessCount()})
+		}
+		info.Capacity = lru.Capacity()
+	}
+	writeJSON(w, http.StatusOK, info)
+}
+
+// get writes the value of an entry.
+//
+// Details:
+//   - LRU caches are inspected without touching recency or hit counters; other caches are read with Get.
+func (h *Handler) get(w http.ResponseWriter, r *http.Request) {
+	name, key := r.PathValue("name"), r.PathValue("key")
+	cache, ok := cachify.LookupCache(name)
+	if !ok {
+		http.Error(w, fmt.Sprintf("cache %q not registered", name), http.StatusNotFound)
+		return
+	}
+	info := entryInfo{Key: key}
+	if lru, isLRU := cache.(*cachify.LRU); isLRU {
+		s, found := lru.GetStateByKey(key)
+		if !found {
+			http.Error(w, fmt.Sprintf("key %q not found", key), http.StatusNotFound)
+			return
+		}
+		accessTime, expiration := s.AccessTime(), s.Expiration()
+		info.Value = s.Value()
+		info.AccessCount = s.AccessCount()
+		info.AccessTime = &accessTime
+		if !expiration.IsZero() {
+			info.Expiration = &expiration
+		}
+	} else {
+		value, found := cache.Get(key)
+		if !found {
+			http.Error(w, fmt.Sprintf("key %q not found", key), http.StatusNotFound)
+			return
+		}
+		info.Value = value
+	}
+	info.Value = h.display(info.Value)
+	writeJSON(w, http.StatusOK, info)
+}
+
+// remove deletes an entry.
+func (h *Handler) remove(w http.ResponseWriter, r *http.Request) {
+	name, key := r.PathValue("name"), r.PathValue("key")
+	cache, ok := cachify.LookupCache(name)
+	if !ok {
+		http.Error(w, fmt.Sprintf("cache %q not registered", name), http.StatusNotFound)
+		return
+	}
+	if !cache.Contains(key) {
+		http.Error(w, fmt.Sprintf("key %q not found", key), http.StatusNotFound)
+		return
+	}
+	cache.Remove(key)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// display returns a value as it should appear in a response: redacted, as JSON, or formatted with %v
+// when it cannot be encoded.
+func (h *Handler) display(value interface{}) interface{} {
+	if h.redact {
+		return redacted
+	}
+	if _, err := json.Marshal(value); err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return value
+}
+
+// writeJSON writes a value as an indented JSON response.
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	_ = encoder.Encode(v)
+}
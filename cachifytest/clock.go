@@ -0,0 +1,63 @@
+package cachifytest
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock is the time source used by the fake to expire entries.
+//
+// Methods:
+//   - Now: Returns the current time.
+type Clock interface {
+	Now() time.Time
+}
+
+// ManualClock is a Clock that only moves when told to, making expiration deterministic in tests.
+//
+// Fields:
+//   - mutex: A lock protecting the current time.
+//   - now: The current time.
+type ManualClock struct {
+	mutex sync.Mutex
+	now   time.Time
+}
+
+// NewManualClock creates a manual clock starting at the given time.
+//
+// Parameters:
+//   - start: The initial time. The zero time starts at a fixed date.
+//
+// Returns:
+//   - A pointer to an initialized ManualClock.
+func NewManualClock(start time.Time) *ManualClock {
+	if start.IsZero() {
+		start = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	}
+	return &ManualClock{now: start}
+}
+
+// Now returns the current time of the clock.
+func (c *ManualClock) Now() time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.now
+}
+
+// Advance moves the clock forward.
+//
+// Parameters:
+//   - d: The duration to move forward.
+func (c *ManualClock) Advance(d time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// systemClock is the Clock backed by time.Now.
+type systemClock struct{}
+
+// Now returns the wall-clock time.
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
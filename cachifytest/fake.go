@@ -0,0 +1,271 @@
+// Package cachifytest provides a controllable fake implementing cachify.Cache, so code that depends
+// on a cache can be unit-tested deterministically: lookups can be scripted, calls are recorded,
+// and expiration follows an injectable clock.
+package cachifytest
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pnguyen215/cachify"
+)
+
+// Call records one invocation of the fake.
+//
+// Fields:
+//   - Method: The method name, e.g. "Get" or "Set".
+//   - Key: The key argument, if any.
+//   - Value: The value argument for Set, or the returned value for Get.
+//   - Hit: For Get, whether the lookup hit.
+type Call struct {
+	Method string
+	Key    string
+	Value  interface{}
+	Hit    bool
+}
+
+// Fake is an in-memory cachify.Cache with scripting and introspection hooks.
+//
+// Fields:
+//   - mutex: A lock protecting every field.
+//   - data: The stored entries.
+//   - script: Forced outcomes for Get, keyed by cache key.
+//   - calls: Every recorded call, in order.
+//   - hook: An optional function invoked after every call.
+//   - clock: The time source used for expiration.
+//   - ttl: The time-to-live of stored entries. Zero means entries never expire.
+//   - stats: The hit and miss counters.
+type Fake struct {
+	mutex  sync.Mutex
+	data   map[string]fakeEntry
+	script map[string]outcome
+	calls  []Call
+	hook   func(Call)
+	clock  Clock
+	ttl    time.Duration
+	stats  cachify.Stats
+}
+
+// fakeEntry represents a value stored in the fake.
+// Fields:
+//   - value: The stored value.
+//   - expiration: The expiration time. The zero time means the entry never expires.
+type fakeEntry struct {
+	value      interface{}
+	expiration time.Time
+}
+
+// outcome represents a scripted Get result.
+// Fields:
+//   - hit: Whether the lookup should hit.
+//   - value: The value returned on a hit.
+type outcome struct {
+	hit   bool
+	value interface{}
+}
+
+var _ cachify.Cache = (*Fake)(nil)
+
+// New creates an empty fake using the system clock and no expiration.
+//
+// Returns:
+//   - A pointer to an initialized Fake.
+func New() *Fake {
+	return &Fake{
+		data:   make(map[string]fakeEntry),
+		script: make(map[string]outcome),
+		clock:  systemClock{},
+	}
+}
+
+// WithClock sets the time source used for expiration, typically a *ManualClock.
+//
+// Returns:
+//   - The Fake, for chaining.
+func (f *Fake) WithClock(clock Clock) *Fake {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.clock = clock
+	return f
+}
+
+// WithTTL sets the time-to-live of entries stored from now on.
+//
+// Returns:
+//   - The Fake, for chaining.
+func (f *Fake) WithTTL(ttl time.Duration) *Fake {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.ttl = ttl
+	return f
+}
+
+// OnCall sets a function invoked after every call, e.g. to block or fail a test at a precise point.
+//
+// Returns:
+//   - The Fake, for chaining.
+func (f *Fake) OnCall(hook func(Call)) *Fake {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.hook = hook
+	return f
+}
+
+// ForceHit makes every Get of a key hit with the given value, whatever is stored.
+func (f *Fake) ForceHit(key string, value interface{}) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.script[key] = outcome{hit: true, value: value}
+}
+
+// ForceMiss makes every Get of a key miss, whatever is stored.
+func (f *Fake) ForceMiss(key string) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.script[key] = outcome{}
+}
+
+// Unscript removes the forced outcome of a key, so Get reads the stored entries again.
+func (f *Fake) Unscript(key string) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	delete(f.script, key)
+}
+
+// Calls returns a copy of every recorded call, in order.
+func (f *Fake) Calls() []Call {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return append([]Call(nil), f.calls...)
+}
+
+// CallCount returns the number of recorded calls of a method.
+//
+// Parameters:
+//   - method: The method name, e.g. "Get".
+func (f *Fake) CallCount(method string) int {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	n := 0
+	for _, call := range f.calls {
+		if call.Method == method {
+			n++
+		}
+	}
+	return n
+}
+
+// ResetCalls forgets every recorded call.
+func (f *Fake) ResetCalls() {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.calls = nil
+}
+
+// Get retrieves a value, honoring scripted outcomes and expiration.
+func (f *Fake) Get(key string) (value interface{}, ok bool) {
+	f.mutex.Lock()
+	if scripted, exists := f.script[key]; exists {
+		value, ok = scripted.value, scripted.hit
+	} else if entry, exists := f.data[key]; exists {
+		if !entry.expiration.IsZero() && f.clock.Now().After(entry.expiration) {
+			delete(f.data, key)
+			f.stats.Expirations++
+		} else {
+			value, ok = entry.value, true
+		}
+	}
+	if ok {
+		f.stats.Hits++
+	} else {
+		f.stats.Misses++
+	}
+	hook := f.record(Call{Method: "Get", Key: key, Value: value, Hit: ok})
+	f.mutex.Unlock()
+	if hook != nil {
+		hook()
+	}
+	return value, ok
+}
+
+// Set stores a value.
+func (f *Fake) Set(key string, value interface{}) {
+	f.mutex.Lock()
+	entry := fakeEntry{value: value}
+	if f.ttl > 0 {
+		entry.expiration = f.clock.Now().Add(f.ttl)
+	}
+	f.data[key] = entry
+	hook := f.record(Call{Method: "Set", Key: key, Value: value})
+	f.mutex.Unlock()
+	if hook != nil {
+		hook()
+	}
+}
+
+// Remove deletes a key.
+func (f *Fake) Remove(key string) {
+	f.mutex.Lock()
+	delete(f.data, key)
+	hook := f.record(Call{Method: "Remove", Key: key})
+	f.mutex.Unlock()
+	if hook != nil {
+		hook()
+	}
+}
+
+// Len returns the number of stored entries, including expired ones not yet read.
+func (f *Fake) Len() int {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return len(f.data)
+}
+
+// Clear removes every stored entry; scripted outcomes are kept.
+func (f *Fake) Clear() {
+	f.mutex.Lock()
+	f.data = make(map[string]fakeEntry)
+	hook := f.record(Call{Method: "Clear"})
+	f.mutex.Unlock()
+	if hook != nil {
+		hook()
+	}
+}
+
+// Contains reports whether a live entry is stored for a key, ignoring scripted outcomes.
+func (f *Fake) Contains(key string) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	entry, exists := f.data[key]
+	return exists && (entry.expiration.IsZero() || !f.clock.Now().After(entry.expiration))
+}
+
+// Stats returns the hit, miss, and expiration counters.
+func (f *Fake) Stats() cachify.Stats {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	stats := f.stats
+	stats.Len = len(f.data)
+	return stats
+}
+
+// Close records the call; the fake holds no resources.
+func (f *Fake) Close() {
+	f.mutex.Lock()
+	hook := f.record(Call{Method: "Close"})
+	f.mutex.Unlock()
+	if hook != nil {
+		hook()
+	}
+}
+
+// record appends a call and returns the hook invocation to run once the lock is released.
+// It must be called with the lock held.
+func (f *Fake) record(call Call) func() {
+	f.calls = append(f.calls, call)
+	if f.hook == nil {
+		return nil
+	}
+	hook := f.hook
+	return func() { hook(call) }
+}
@@ -0,0 +1,53 @@
+package cachify
+
+// SetWithCallback inserts or updates a key-value pair with an eviction callback specific to this value.
+//
+// Parameters:
+//   - key: The key to be added or updated.
+//   - value: The value to be associated with the key.
+//   - callback: The function to invoke when the value leaves the cache, e.g. to close a file handle
+//     or connection owned by the value.
+//
+// Details:
+//   - Behaves like Set regarding recency, expiration, and capacity eviction.
+//   - The callback supplements the cache-wide eviction callback and runs after it, on every removal:
+//     eviction, expiration, Remove, and Clear.
+//   - The callback belongs to the value: it is also invoked, with the old value, when a later write
+//     replaces the value, and is not carried over to the new value.
+//   - Like the eviction callback, it runs with the write lock held and must not call back into the cache.
+func (c *LRU) SetWithCallback(key string, value interface{}, callback OnCallback) {
+	key = c.normalizeKey(key)
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry := c.set(key, value)
+	if entry == nil {
+		return
+	}
+	entry.onEvict = callback
+	c.enforceQuotas(key)
+	c.enforceCapacity(c.cache[key])
+}
+
+// replace runs the cleanup due when the value of an entry leaves the cache: the per-entry eviction
+// callback, which is cleared, and the automatic close of the value (see WithAutoClose).
+//
+// Parameters:
+//   - entry: The entry whose value is leaving.
+//   - next: The value replacing it, or nil when the entry itself is removed.
+//
+// Details:
+//   - Must be called with the write lock held, before the value is overwritten or the entry recycled.
+func (c *LRU) replace(entry *entries, next interface{}) {
+	if entry.onEvict == nil && c.closer == nil {
+		return
+	}
+	key, value := entry.key, decompress(entry.value)
+	if callback := entry.onEvict; callback != nil {
+		entry.onEvict = nil
+		invoke(&c.stats, c.onPanic, "evict", key, func() { callback(key, value) })
+	}
+	if c.closer != nil && !sameValue(value, next) {
+		c.closer.release(&c.stats, c.onPanic, key, value)
+	}
+}
@@ -0,0 +1,200 @@
+package cachify
+
+// NewClock creates a new CLOCK cache with the specified capacity.
+//
+// Parameters:
+//   - capacity: The maximum number of items the cache can hold. Zero or less means unbounded.
+//
+// Returns:
+//   - A pointer to an initialized Clock cache.
+func NewClock(capacity int) *Clock {
+	size := capacity
+	if size < 0 {
+		size = 0
+	}
+	return &Clock{
+		capacity: capacity,
+		slots:    make([]*clockSlot, 0, size),
+		index:    make(map[string]int, size),
+	}
+}
+
+// NewClockCallback creates a new CLOCK cache with the specified capacity and eviction callback.
+//
+// Parameters:
+//   - capacity: The maximum number of items the cache can hold.
+//   - callback: A function of type `OnCallback` invoked when an item is evicted.
+//
+// Returns:
+//   - A pointer to an initialized Clock cache.
+func NewClockCallback(capacity int, callback OnCallback) *Clock {
+	c := NewClock(capacity)
+	c.onEvict = callback
+	return c
+}
+
+// Get retrieves the value associated with a given key.
+//
+// Parameters:
+//   - key: The key whose value is to be retrieved.
+//
+// Returns:
+//   - The value associated with the key, or nil if the key is not found.
+//   - A boolean indicating whether the key exists.
+//
+// Details:
+//   - Only sets the entry's reference bit, under the read lock; no reordering takes place.
+func (c *Clock) Get(key string) (value interface{}, ok bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	i, exists := c.index[key]
+	if !exists {
+		c.stats.miss()
+		return nil, false
+	}
+	slot := c.slots[i]
+	slot.referenced.Store(true)
+	c.stats.hit()
+	return slot.value, true
+}
+
+// Set inserts or updates a key-value pair in the cache.
+//
+// Parameters:
+//   - key: The key to be added or updated.
+//   - value: The value to be associated with the key.
+//
+// Details:
+//   - When the cache is full, the hand sweeps the buffer: referenced entries get a second chance
+//     and have their bit cleared, and the first unreferenced entry is evicted.
+func (c *Clock) Set(key string, value interface{}) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if i, exists := c.index[key]; exists {
+		slot := c.slots[i]
+		slot.value = value
+		slot.referenced.Store(true)
+		return
+	}
+	i := c.place()
+	slot := c.slots[i]
+	slot.key = key
+	slot.value = value
+	slot.used = true
+	slot.referenced.Store(false)
+	c.index[key] = i
+}
+
+// Remove deletes a key from the cache.
+//
+// Parameters:
+//   - key: The key to be removed.
+func (c *Clock) Remove(key string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if i, exists := c.index[key]; exists {
+		c.release(i)
+	}
+}
+
+// Contains checks whether a key exists in the cache without setting its reference bit.
+func (c *Clock) Contains(key string) bool {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	_, exists := c.index[key]
+	return exists
+}
+
+// Len returns the number of items in the cache.
+func (c *Clock) Len() int {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return len(c.index)
+}
+
+// Capacity returns the maximum number of items the cache can hold.
+func (c *Clock) Capacity() int {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.capacity
+}
+
+// Keys returns the keys in the cache in buffer order.
+func (c *Clock) Keys() []string {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	keys := make([]string, 0, len(c.index))
+	for _, slot := range c.slots {
+		if slot.used {
+			keys = append(keys, slot.key)
+		}
+	}
+	return keys
+}
+
+// Clear removes all items from the cache without invoking the eviction callback.
+func (c *Clock) Clear() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.slots = c.slots[:0]
+	c.index = make(map[string]int)
+	c.free = nil
+	c.hand = 0
+}
+
+// SetCallback sets the callback function invoked when an item is evicted.
+func (c *Clock) SetCallback(callback OnCallback) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.onEvict = callback
+}
+
+// place returns the position of an empty slot for a new entry, evicting one if the cache is full.
+// It must be called with the write lock held.
+func (c *Clock) place() int {
+	if c.capacity > 0 && len(c.index) >= c.capacity {
+		return c.evict()
+	}
+	if n := len(c.free); n > 0 {
+		i := c.free[n-1]
+		c.free = c.free[:n-1]
+		return i
+	}
+	c.slots = append(c.slots, &clockSlot{})
+	return len(c.slots) - 1
+}
+
+// evict advances the hand until it finds an unreferenced entry, evicts it, and returns its position.
+// It must be called with the write lock held on a non-empty cache.
+func (c *Clock) evict() int {
+	for {
+		i := c.hand
+		c.hand = (c.hand + 1) % len(c.slots)
+		slot := c.slots[i]
+		if !slot.used {
+			continue
+		}
+		if slot.referenced.Swap(false) {
+			continue
+		}
+		key, value := slot.key, slot.value
+		delete(c.index, key)
+		c.stats.evict()
+		slot.key, slot.value, slot.used = "", nil, false
+		if c.onEvict != nil {
+			invoke(&c.stats, c.onPanic, "evict", key, func() { c.onEvict(key, value) })
+		}
+		return i
+	}
+}
+
+// release empties a slot and records it for reuse.
+// It must be called with the write lock held.
+func (c *Clock) release(i int) {
+	slot := c.slots[i]
+	delete(c.index, slot.key)
+	slot.key, slot.value, slot.used = "", nil, false
+	slot.referenced.Store(false)
+	c.free = append(c.free, i)
+}
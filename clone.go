@@ -0,0 +1,164 @@
+package cachify
+
+import (
+	"container/list"
+	"reflect"
+)
+
+// Clone creates an independent cache with the same contents, capacity, and configuration.
+//
+// Parameters:
+//   - deep: Whether values are deep-copied. When false, both caches share the same value references.
+//
+// Returns:
+//   - A pointer to a new LRU cache.
+//
+// Details:
+//   - Recency order, expirations, access metadata, tags, pins, quotas, the eviction and expiration
+//     callbacks, and cleanup settings are copied. The clone runs its own background cleanup when the source has expiry enabled.
+//   - Per-entry eviction callbacks (see SetWithCallback) stay with the source cache.
+//   - Deep copies follow pointers, maps, slices, arrays, and interfaces; struct fields are copied
+//     recursively when exported and by value otherwise.
+func (c *LRU) Clone(deep bool) *LRU {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	clone := NewLRU(c.capacity)
+	clone.onEvict = c.onEvict
+	clone.onEvent = c.onEvent
+	clone.onExpire = c.onExpire
+	clone.onPanic = c.onPanic
+	clone.copier = c.copier
+	clone.serialize = c.serialize
+	clone.lowWatermark = c.lowWatermark
+	clone.codec = c.codec
+	clone.compressor = c.compressor
+	clone.compressThreshold = c.compressThreshold
+	clone.aead = c.aead
+	clone.sizer = c.sizer
+	clone.maxValueSize = c.maxValueSize
+	clone.policy = c.policy
+	clone.version = c.version
+	clone.keyTransform = c.keyTransform
+	clone.indexer = c.indexer
+	clone.hashValues = c.hashValues
+	clone.dedup = c.dedup
+	clone.minTTL = c.minTTL
+	clone.maxTTL = c.maxTTL
+	if c.reads != nil {
+		clone.reads = newReadBuffer(c.reads.size)
+	}
+	if c.latency.Load() != nil {
+		clone.latency.Store(&latencies{})
+	}
+	if namespaces := c.namespaces.Load(); namespaces != nil {
+		tracked := make(map[string]*counters, len(*namespaces))
+		for prefix := range *namespaces {
+			tracked[prefix] = &counters{}
+		}
+		clone.namespaces.Store(&tracked)
+	}
+	if w := c.stats.window.Load(); w != nil {
+		clone.WithStatsWindow(w.width, len(w.buckets))
+	}
+	clone.cleanupInterval = c.cleanupInterval
+	clone.lazy = c.lazy
+	clone.sweepEntries = c.sweepEntries
+	clone.sweepDuration = c.sweepDuration
+	if len(c.quotas) > 0 {
+		clone.quotas = make(map[string]*quota, len(c.quotas))
+		for prefix, q := range c.quotas {
+			clone.quotas[prefix] = newQuota(q.maxEntries)
+		}
+	}
+	clone.cache = make(map[string]*list.Element, max(mapHint(c.capacity), len(c.cache)))
+	for element := c.list.Front(); element != nil; element = element.Next() {
+		entry := *element.Value.(*entries)
+		entry.tags = append([]string(nil), entry.tags...)
+		entry.indexed = nil
+		entry.hashed = false
+		// The source still owns the values' resources, so they must not be released twice
+		entry.onEvict = nil
+		if deep {
+			entry.value = DeepCopy(entry.value)
+		}
+		entry.value = clone.intern(entry.value)
+		clone.cache[entry.key] = clone.list.PushBack(&entry)
+		clone.tag(&entry)
+		clone.reindex(&entry, clone.view(entry.value))
+	}
+	clone.orderQuotas()
+	clone.expiration = c.expiration
+	if c.stopCleanup != nil {
+		clone.startJanitor()
+	}
+	return clone
+}
+
+// DeepCopy returns a recursive copy of a value. It can be used as a Copier.
+//
+// Details:
+//   - Pointers, maps, slices, arrays, and interfaces are followed; struct fields are copied
+//     recursively when exported and by value otherwise.
+func DeepCopy(value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	return copyValue(reflect.ValueOf(value)).Interface()
+}
+
+// copyValue recursively copies a reflected value.
+func copyValue(src reflect.Value) reflect.Value {
+	switch src.Kind() {
+	case reflect.Ptr:
+		if src.IsNil() {
+			return src
+		}
+		dst := reflect.New(src.Elem().Type())
+		dst.Elem().Set(copyValue(src.Elem()))
+		return dst
+	case reflect.Interface:
+		if src.IsNil() {
+			return src
+		}
+		dst := reflect.New(src.Type()).Elem()
+		dst.Set(copyValue(src.Elem()))
+		return dst
+	case reflect.Map:
+		if src.IsNil() {
+			return src
+		}
+		dst := reflect.MakeMapWithSize(src.Type(), src.Len())
+		iter := src.MapRange()
+		for iter.Next() {
+			dst.SetMapIndex(copyValue(iter.Key()), copyValue(iter.Value()))
+		}
+		return dst
+	case reflect.Slice:
+		if src.IsNil() {
+			return src
+		}
+		dst := reflect.MakeSlice(src.Type(), src.Len(), src.Len())
+		for i := 0; i < src.Len(); i++ {
+			dst.Index(i).Set(copyValue(src.Index(i)))
+		}
+		return dst
+	case reflect.Array:
+		dst := reflect.New(src.Type()).Elem()
+		for i := 0; i < src.Len(); i++ {
+			dst.Index(i).Set(copyValue(src.Index(i)))
+		}
+		return dst
+	case reflect.Struct:
+		dst := reflect.New(src.Type()).Elem()
+		dst.Set(src)
+		for i := 0; i < src.NumField(); i++ {
+			if dst.Field(i).CanSet() {
+				dst.Field(i).Set(copyValue(src.Field(i)))
+			}
+		}
+		return dst
+	default:
+		return src
+	}
+}
@@ -0,0 +1,77 @@
+package cachify
+
+import (
+	"io"
+	"reflect"
+)
+
+// WithAutoClose makes the cache release values implementing io.Closer or Releaser once they leave it.
+//
+// Parameters:
+//   - onError: An optional callback receiving the key and the error of a failed Close.
+//
+// Returns:
+//   - The LRU cache, for chaining.
+//
+// Details:
+//   - A value leaves the cache when it is evicted, expires, is removed or cleared, or is replaced by
+//     a different value for the same key. Writing the same value again does not release it.
+//   - Close and Release run in their own goroutine, so a slow release never holds the lock; Release is
+//     preferred when a value implements both.
+//   - Values that are still referenced elsewhere, e.g. returned by a Get in flight or shared with a
+//     shallow Clone, are released all the same, so callers must not keep them beyond their use.
+//     Clones therefore do not inherit this setting.
+//   - Panics in Close or Release are recovered like callback panics (see WithPanicCallback).
+func (c *LRU) WithAutoClose(onError OnErrorCallback) *LRU {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.closer = &valueCloser{onError: onError}
+	return c
+}
+
+// WithoutAutoClose stops releasing values that leave the cache.
+//
+// Returns:
+//   - The LRU cache, for chaining.
+func (c *LRU) WithoutAutoClose() *LRU {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.closer = nil
+	return c
+}
+
+// IsAutoClose checks whether values are released when they leave the cache.
+func (c *LRU) IsAutoClose() bool {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.closer != nil
+}
+
+// release closes a value in the background if it implements Releaser or io.Closer.
+//
+// Parameters:
+//   - stats: The counters where a panicking Close or Release is counted.
+//   - onPanic: The callback notified of such a panic, or nil.
+//   - key: The key the value was stored under, reported with errors.
+//   - value: The value leaving the cache.
+func (v *valueCloser) release(stats *counters, onPanic OnErrorCallback, key string, value interface{}) {
+	switch resource := value.(type) {
+	case Releaser:
+		go invoke(stats, onPanic, "close", key, resource.Release)
+	case io.Closer:
+		go invoke(stats, onPanic, "close", key, func() {
+			if err := resource.Close(); err != nil && v.onError != nil {
+				v.onError(key, err)
+			}
+		})
+	}
+}
+
+// sameValue reports whether two values are the same, without panicking on uncomparable types.
+func sameValue(a, b interface{}) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	t := reflect.TypeOf(a)
+	return t == reflect.TypeOf(b) && t.Comparable() && a == b
+}
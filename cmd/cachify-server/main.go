@@ -0,0 +1,79 @@
+// Command cachify-server runs a cachify cache as a standalone HTTP service, a lightweight shared cache
+// for small deployments that do not want to run Redis.
+//
+// Usage:
+//
+//	cachify-server -addr :7070 -capacity 100000 -expiry 10m -debug
+//
+// See package remote for the protocol; remote.NewClient talks to it.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/pnguyen215/cachify/cachifydebug"
+	"github.com/pnguyen215/cachify/remote"
+)
+
+func main() {
+	addr := flag.String("addr", ":7070", "address to listen on")
+	capacity := flag.Int("capacity", 100000, "maximum number of entries; zero or less is unbounded")
+	expiry := flag.Duration("expiry", 0, "default expiration of entries; zero means entries only expire by their own ttl")
+	maxValueSize := flag.Int64("max-value-size", 32<<20, "largest accepted value in bytes")
+	debug := flag.Bool("debug", false, "serve the inspection endpoints under /debug/cachify/")
+	flag.Parse()
+
+	if err := run(*addr, *capacity, *expiry, *maxValueSize, *debug); err != nil {
+		fmt.Fprintln(os.Stderr, "cachify-server:", err)
+		os.Exit(1)
+	}
+}
+
+// run serves the cache until the process is interrupted, then shuts down gracefully.
+func run(addr string, capacity int, expiry time.Duration, maxValueSize int64, debug bool) error {
+	var cache *cachify.LRU
+	if expiry > 0 {
+		cache = cachify.NewLRUExpires(capacity, expiry)
+	} else {
+		cache = cachify.NewLRU(capacity)
+	}
+	defer cache.Close()
+
+	mux := http.NewServeMux()
+	mux.Handle("/v1/", remote.NewServer(cache).WithMaxValueSize(maxValueSize))
+	if debug {
+		cachify.Register("server", cache)
+		mux.Handle("/debug/cachify/", http.StripPrefix("/debug/cachify", cachifydebug.NewHandler().WithRedaction(true)))
+	}
+	server := &http.Server{Addr: addr, Handler: mux}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	errs := make(chan error, 1)
+	go func() {
+		log.Printf("cachify-server listening on %s", addr)
+		errs <- server.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errs:
+		return err
+	case <-ctx.Done():
+	}
+	shutdown, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := server.Shutdown(shutdown); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
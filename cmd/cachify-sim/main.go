@@ -0,0 +1,71 @@
+// Command cachify-sim replays an access trace against several cache capacities and eviction policies
+// and prints the resulting hit ratios.
+//
+// Usage:
+//
+//	cachify-sim -capacities 1000,10000 -policies lru,clock trace.txt
+//
+// The trace holds one key per whitespace-separated token; it is read from stdin when no file is given.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pnguyen215/cachify/simulator"
+)
+
+func main() {
+	capacities := flag.String("capacities", "1000", "comma-separated cache capacities")
+	policies := flag.String("policies", "", "comma-separated policies (lru, mru, clock, lru2); all when empty")
+	flag.Parse()
+
+	if err := run(*capacities, *policies, flag.Args()); err != nil {
+		fmt.Fprintln(os.Stderr, "cachify-sim:", err)
+		os.Exit(1)
+	}
+}
+
+// run parses the flags, replays the trace, and prints one line per result.
+func run(capacityList, policyList string, args []string) error {
+	var capacities []int
+	for _, field := range strings.Split(capacityList, ",") {
+		capacity, err := strconv.Atoi(strings.TrimSpace(field))
+		if err != nil {
+			return fmt.Errorf("invalid capacity %q", field)
+		}
+		capacities = append(capacities, capacity)
+	}
+	var policies []simulator.Policy
+	if policyList != "" {
+		for _, name := range strings.Split(policyList, ",") {
+			policy, ok := simulator.Lookup(strings.TrimSpace(name))
+			if !ok {
+				return fmt.Errorf("unknown policy %q", name)
+			}
+			policies = append(policies, policy)
+		}
+	}
+
+	var trace io.Reader = os.Stdin
+	if len(args) > 0 {
+		f, err := os.Open(args[0])
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		trace = f
+	}
+	results, err := simulator.Replay(trace, capacities, policies...)
+	if err != nil {
+		return err
+	}
+	for _, result := range results {
+		fmt.Println(result)
+	}
+	return nil
+}
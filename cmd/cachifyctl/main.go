@@ -0,0 +1,213 @@
+// Command cachifyctl inspects cachify snapshot files without writing Go.
+//
+// Usage:
+//
+//	cachifyctl keys [flags] snapshot                  list keys with their remaining TTL
+//	cachifyctl show [flags] snapshot key              print one entry as JSON
+//	cachifyctl diff [flags] old new                   list added (+), removed (-) and changed (~) keys
+//	cachifyctl convert [flags] -to json|binary in out convert between the JSON and binary formats
+//
+// Flags (every command):
+//
+//	-codec name   codec of binary snapshot values (gob by default, as written by LRU.SaveToFile)
+//	-key hex      AES key of encrypted binary snapshots
+//
+// Binary snapshots are the files written by LRU.SaveToFile; JSON snapshots are arrays of cachify.Entry,
+// as produced by encoding LRU.Snapshot(). The format of an input file is detected from its content.
+// With the gob codec, values of custom types must be registered with gob, so such files are best
+// inspected with a JSON codec or from a program that registers them.
+package main
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/pnguyen215/cachify"
+)
+
+const usage = `usage:
+  cachifyctl keys [flags] snapshot
+  cachifyctl show [flags] snapshot key
+  cachifyctl diff [flags] old new
+  cachifyctl convert [flags] -to json|binary in out`
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "cachifyctl:", err)
+		os.Exit(1)
+	}
+}
+
+// options holds the flags shared by every command.
+//
+// Fields:
+//   - codec: The codec of binary snapshot values.
+//   - aead: The cipher of encrypted binary snapshots, or nil.
+//   - to: The output format of convert.
+type options struct {
+	codec cachify.Codec
+	aead  cipher.AEAD
+	to    string
+}
+
+// run parses the command line and executes a command, writing its output to out.
+func run(args []string, out io.Writer) error {
+	if len(args) == 0 {
+		return errors.New(usage)
+	}
+	command := args[0]
+	flags := flag.NewFlagSet(command, flag.ContinueOnError)
+	codecName := flags.String("codec", "gob", "codec of binary snapshot values")
+	keyHex := flags.String("key", "", "hex-encoded AES key of encrypted binary snapshots")
+	to := flags.String("to", "", "output format of convert: json or binary")
+	if err := flags.Parse(args[1:]); err != nil {
+		return err
+	}
+	opts := options{to: *to}
+	codec, ok := cachify.LookupCodec(*codecName)
+	if !ok {
+		return fmt.Errorf("unknown codec %q", *codecName)
+	}
+	opts.codec = codec
+	if *keyHex != "" {
+		key, err := hex.DecodeString(*keyHex)
+		if err != nil {
+			return fmt.Errorf("invalid key: %w", err)
+		}
+		if opts.aead, err = cachify.NewAESGCM(key); err != nil {
+			return err
+		}
+	}
+
+	operands := flags.Args()
+	switch {
+	case command == "keys" && len(operands) == 1:
+		return keys(out, operands[0], opts)
+	case command == "show" && len(operands) == 2:
+		return show(out, operands[0], operands[1], opts)
+	case command == "diff" && len(operands) == 2:
+		return diff(out, operands[0], operands[1], opts)
+	case command == "convert" && len(operands) == 2:
+		return convert(operands[0], operands[1], opts)
+	default:
+		return errors.New(usage)
+	}
+}
+
+// keys prints every key with its remaining time-to-live, most recently used first.
+func keys(out io.Writer, path string, opts options) error {
+	snapshot, err := read(path, opts)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	for _, e := range snapshot {
+		ttl := "never"
+		switch {
+		case e.Expiration.IsZero():
+		case !now.Before(e.Expiration):
+			ttl = "expired"
+		default:
+			ttl = e.Expiration.Sub(now).Round(time.Second).String()
+		}
+		fmt.Fprintf(out, "%s\t%s\n", e.Key, ttl)
+	}
+	return nil
+}
+
+// show prints one entry as indented JSON.
+func show(out io.Writer, path, key string, opts options) error {
+	snapshot, err := read(path, opts)
+	if err != nil {
+		return err
+	}
+	for _, e := range snapshot {
+		if e.Key == key {
+			encoder := json.NewEncoder(out)
+			encoder.SetIndent("", "  ")
+			return encoder.Encode(e)
+		}
+	}
+	return fmt.Errorf("key %q not found", key)
+}
+
+// diff prints the keys added, removed, or changed between two snapshots, in lexical order.
+func diff(out io.Writer, oldPath, newPath string, opts options) error {
+	before, err := read(oldPath, opts)
+	if err != nil {
+		return err
+	}
+	after, err := read(newPath, opts)
+	if err != nil {
+		return err
+	}
+	old := make(map[string]cachify.Entry, len(before))
+	for _, e := range before {
+		old[e.Key] = e
+	}
+	var lines []string
+	for _, e := range after {
+		previous, exists := old[e.Key]
+		delete(old, e.Key)
+		switch {
+		case !exists:
+			lines = append(lines, "+ "+e.Key)
+		case !reflect.DeepEqual(previous.Value, e.Value) || !previous.Expiration.Equal(e.Expiration):
+			lines = append(lines, "~ "+e.Key)
+		}
+	}
+	for key := range old {
+		lines = append(lines, "- "+key)
+	}
+	sort.Slice(lines, func(i, j int) bool { return lines[i][2:] < lines[j][2:] })
+	for _, line := range lines {
+		fmt.Fprintln(out, line)
+	}
+	return nil
+}
+
+// convert rewrites a snapshot in the requested format.
+func convert(in, out string, opts options) error {
+	snapshot, err := read(in, opts)
+	if err != nil {
+		return err
+	}
+	switch opts.to {
+	case "json":
+		data, err := json.MarshalIndent(snapshot, "", "  ")
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(out, append(data, '\n'), 0o644)
+	case "binary":
+		return cachify.WriteSnapshotFile(out, snapshot, opts.codec, opts.aead)
+	default:
+		return fmt.Errorf("-to must be json or binary, not %q", opts.to)
+	}
+}
+
+// read loads a snapshot file in either format.
+func read(path string, opts options) ([]cachify.Entry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		var snapshot []cachify.Entry
+		if err := json.Unmarshal(trimmed, &snapshot); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		return snapshot, nil
+	}
+	return cachify.ReadSnapshotFile(path, opts.codec, opts.aead)
+}
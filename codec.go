@@ -0,0 +1,118 @@
+package cachify
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"sync"
+)
+
+// Marshal encodes a value as JSON.
+//
+// Parameters:
+//   - value: The value to encode.
+//
+// Returns:
+//   - The JSON representation of the value.
+//   - An error if the value cannot be encoded.
+func (JSONCodec) Marshal(value interface{}) ([]byte, error) {
+	return json.Marshal(value)
+}
+
+// Unmarshal decodes JSON data into the value pointed to by v.
+//
+// Parameters:
+//   - data: The JSON data to decode.
+//   - v: A pointer to the destination value.
+//
+// Returns:
+//   - An error if the data cannot be decoded.
+func (JSONCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+// Marshal encodes a value with gob as an interface{} value.
+//
+// Parameters:
+//   - value: The value to encode. Its concrete type must be registered with gob.Register.
+//
+// Returns:
+//   - The gob representation of the value.
+//   - An error if the value cannot be encoded.
+func (GobCodec) Marshal(value interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(&value); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Unmarshal decodes gob data into the value pointed to by v.
+//
+// Parameters:
+//   - data: The gob data to decode.
+//   - v: A pointer to the destination value, typically a *interface{}.
+//
+// Returns:
+//   - An error if the data cannot be decoded.
+func (GobCodec) Unmarshal(data []byte, v interface{}) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(v)
+}
+
+// codecs holds the codecs available by name, e.g. for configuration files and command-line flags.
+var codecs = struct {
+	sync.RWMutex
+	byName map[string]Codec
+}{byName: map[string]Codec{
+	"json": JSONCodec{},
+	"gob":  GobCodec{},
+}}
+
+// RegisterCodec makes a codec available by name, so formats such as msgpack or protobuf can be plugged in.
+//
+// Parameters:
+//   - name: The codec name, e.g. "msgpack". Registering an existing name replaces it.
+//   - codec: The codec implementation.
+func RegisterCodec(name string, codec Codec) {
+	codecs.Lock()
+	defer codecs.Unlock()
+	codecs.byName[name] = codec
+}
+
+// LookupCodec returns the codec registered under a name.
+//
+// Parameters:
+//   - name: The codec name. "json" and "gob" are always available.
+//
+// Returns:
+//   - The codec and a boolean indicating whether it was found.
+func LookupCodec(name string) (Codec, bool) {
+	codecs.RLock()
+	defer codecs.RUnlock()
+	codec, ok := codecs.byName[name]
+	return codec, ok
+}
+
+// WithCodec sets the codec used to encode values in snapshot files.
+//
+// Parameters:
+//   - codec: The codec to use. Nil restores the default GobCodec.
+//
+// Returns:
+//   - The LRU cache, for chaining.
+func (c *LRU) WithCodec(codec Codec) *LRU {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.codec = codec
+	return c
+}
+
+// Codec returns the codec used to encode values in snapshot files.
+func (c *LRU) Codec() Codec {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	if c.codec == nil {
+		return GobCodec{}
+	}
+	return c.codec
+}
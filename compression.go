@@ -0,0 +1,119 @@
+package cachify
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+
+	"github.com/golang/snappy"
+)
+
+// WithCompression transparently compresses large string and []byte values.
+//
+// Parameters:
+//   - compressor: The compression algorithm, e.g. GzipCompressor{} or SnappyCompressor{}. Nil disables compression.
+//   - threshold: The minimum value size, in bytes, that is compressed. Zero or negative uses 1 KiB.
+//
+// Returns:
+//   - The LRU cache, for chaining.
+//
+// Details:
+//   - Values are compressed on Set and decompressed on every read, so callers always see the original value.
+//   - Smaller values, other types, and values that do not shrink are stored as-is.
+//   - Only affects values written after the call; existing entries stay readable.
+func (c *LRU) WithCompression(compressor Compressor, threshold int) *LRU {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if threshold <= 0 {
+		threshold = defaultCompressThreshold
+	}
+	c.compressor = compressor
+	c.compressThreshold = threshold
+	return c
+}
+
+// Compress compresses data with gzip.
+func (g GzipCompressor) Compress(data []byte) ([]byte, error) {
+	level := g.Level
+	if level == 0 {
+		level = gzip.DefaultCompression
+	}
+	var buf bytes.Buffer
+	w, err := gzip.NewWriterLevel(&buf, level)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Decompress restores gzip-compressed data.
+func (GzipCompressor) Decompress(data []byte) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+// Compress compresses data with snappy.
+func (SnappyCompressor) Compress(data []byte) ([]byte, error) {
+	return snappy.Encode(nil, data), nil
+}
+
+// Decompress restores snappy-compressed data.
+func (SnappyCompressor) Decompress(data []byte) ([]byte, error) {
+	return snappy.Decode(nil, data)
+}
+
+// compress returns the form of a value to store, compressing it when eligible.
+// It must be called with the lock held.
+func (c *LRU) compress(value interface{}) interface{} {
+	if c.compressor == nil {
+		return value
+	}
+	var data []byte
+	text := false
+	switch v := value.(type) {
+	case string:
+		data, text = []byte(v), true
+	case []byte:
+		data = v
+	default:
+		return value
+	}
+	if len(data) < c.compressThreshold {
+		return value
+	}
+	packed, err := c.compressor.Compress(data)
+	if err != nil || len(packed) >= len(data) {
+		return value
+	}
+	return compressed{data: packed, text: text, compressor: c.compressor}
+}
+
+// decompress returns the original form of a stored value, decoding serialized values.
+// A value that fails to decompress or decode is returned as nil.
+func decompress(value interface{}) interface{} {
+	if v, ok := value.(encoded); ok {
+		return v.decode()
+	}
+	v, ok := value.(compressed)
+	if !ok {
+		return value
+	}
+	data, err := v.compressor.Decompress(v.data)
+	if err != nil {
+		return nil
+	}
+	if v.text {
+		return string(data)
+	}
+	return data
+}
@@ -1 +1,140 @@
 package cachify
+
+import (
+	"time"
+)
+
+// defaultCleanupInterval is the background cleanup interval used when neither
+// a cleanup interval nor an expiration is configured.
+const defaultCleanupInterval = time.Minute
+
+// minCleanupInterval is the shortest background cleanup interval, so a very short expiration cannot
+// turn the cleanup goroutine into a busy loop or a zero-period ticker.
+const minCleanupInterval = time.Millisecond
+
+// defaultPreloadConcurrency is the number of Loader calls Preload runs at once
+// unless overridden with WithConcurrency.
+const defaultPreloadConcurrency = 16
+
+// defaultBatchWindow is how long NewBatchLoading collects misses into a batch unless overridden.
+const defaultBatchWindow = time.Millisecond
+
+// defaultWriteBehindInterval is how often NewWriteBehind flushes dirty entries when given no interval.
+const defaultWriteBehindInterval = time.Second
+
+// defaultCompressThreshold is the minimum value size, in bytes, compressed by WithCompression
+// when no threshold is given.
+const defaultCompressThreshold = 1024
+
+const (
+	// EvictLRU evicts the least recently used entry. It is the default policy.
+	EvictLRU EvictionPolicy = iota
+	// EvictMRU evicts the most recently used entry other than the one being written,
+	// which suits cyclic scans where the item just used is the least likely to be needed again.
+	EvictMRU
+)
+
+const (
+	// ghostGrowRatio is the ghost-hit ratio above which adaptive capacity grows.
+	ghostGrowRatio = 0.05
+	// ghostResizeFactor is the fraction of the capacity added or removed per adaptation.
+	ghostResizeFactor = 10
+)
+
+const (
+	// keySeparator joins the parts of a composite key built with Key.
+	keySeparator = ':'
+	// keyEscape precedes separators and escape characters found inside a key part.
+	keyEscape = '\\'
+	// keyEscapes lists the characters escaped inside a key part.
+	keyEscapes = ":\\"
+)
+
+// defaultStripes is the number of stripes used by NewStriped when none is given.
+const defaultStripes = 16
+
+const (
+	// defaultSkewThreshold is the access skew tolerated by Striped.Skew when none is given.
+	defaultSkewThreshold = 2.0
+	// defaultSkewCandidates is the number of seeds Striped.Skew evaluates when looking for a better spread.
+	defaultSkewCandidates = 16
+)
+
+// defaultReadBufferSize is the number of accesses batched per drain when buffered access is enabled.
+const defaultReadBufferSize = 64
+
+// maxPreallocate bounds the number of map slots reserved up front from the capacity, so that
+// very large capacities do not allocate memory before any entry is stored.
+const maxPreallocate = 1 << 16
+
+// latencyBounds are the upper bounds of the latency histogram buckets, spanning in-memory hits to slow loads.
+var latencyBounds = [...]time.Duration{
+	250 * time.Nanosecond,
+	500 * time.Nanosecond,
+	time.Microsecond,
+	2500 * time.Nanosecond,
+	5 * time.Microsecond,
+	10 * time.Microsecond,
+	25 * time.Microsecond,
+	50 * time.Microsecond,
+	100 * time.Microsecond,
+	250 * time.Microsecond,
+	500 * time.Microsecond,
+	time.Millisecond,
+	2500 * time.Microsecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+}
+
+// defaultRingReplicas is the number of virtual points each StoreRing node owns unless overridden.
+const defaultRingReplicas = 160
+
+const (
+	// OrderNone returns states in no particular order. It is the default.
+	OrderNone StateOrder = iota
+	// OrderMostRecent returns states from the most to the least recently used.
+	OrderMostRecent
+	// OrderLeastRecent returns states from the least to the most recently used, i.e. the next
+	// entries to be evicted under EvictLRU first.
+	OrderLeastRecent
+	// OrderExpiration returns states from the soonest to expire to the latest; entries that never
+	// expire come last.
+	OrderExpiration
+	// OrderAccessCount returns states from the most to the least frequently read.
+	OrderAccessCount
+)
+
+const (
+	// EventSet reports a key inserted or updated.
+	EventSet EventOp = iota
+	// EventRemove reports a key removed explicitly, e.g. by Remove, a tag, or a prefix.
+	EventRemove
+	// EventEvict reports a key evicted to respect the capacity or a namespace quota.
+	EventEvict
+	// EventExpire reports a key removed because it expired.
+	EventExpire
+	// EventClear reports every key removed at once by Clear.
+	EventClear
+	// EventResize reports a key evicted because SetCapacity or Resize shrank the cache.
+	EventResize
+)
+
+// defaultExportBuffer is the number of events an Exporter buffers unless overridden.
+const defaultExportBuffer = 1024
+
+const (
+	// defaultControllerInterval is how often a CapacityController adjusts the capacity unless overridden.
+	defaultControllerInterval = 10 * time.Second
+	// defaultTargetHitRatio is the hit ratio below which a CapacityController grows a full cache.
+	defaultTargetHitRatio = 0.9
+	// defaultHeadroom is the fraction of the memory limit a CapacityController keeps free.
+	defaultHeadroom = 0.1
+	// controllerStep is the fraction of the capacity added or removed by one adjustment.
+	controllerStep = 0.1
+)
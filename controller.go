@@ -0,0 +1,167 @@
+package cachify
+
+import (
+	"math"
+	"runtime/debug"
+	"time"
+)
+
+// NewCapacityController starts adjusting the capacity of a cache between bounds.
+//
+// Parameters:
+//   - cache: The cache whose capacity is adjusted.
+//   - minCapacity: The smallest capacity the controller sets. Values below 1 use 1.
+//   - maxCapacity: The largest capacity the controller sets. Values below minCapacity use minCapacity.
+//   - interval: How often the capacity is adjusted. Zero or less uses 10 seconds.
+//
+// Returns:
+//   - A pointer to a running CapacityController. Close stops it.
+//
+// Details:
+//   - Every interval, the capacity moves by 10% within the bounds: down when the process is short
+//     of memory (see WithMemoryLimit and WithHeadroom), otherwise up when the cache is full and its
+//     hit ratio over the interval is below the target (see WithTargetHitRatio).
+//   - Shrinking evicts like SetCapacity, so evictions are reported as EventResize.
+//   - Memory is read from runtime/metrics, which does not stop the world, so short intervals are cheap.
+//   - Do not combine with WithAdaptiveCapacity, which adjusts the same capacity on other signals.
+func NewCapacityController(cache *LRU, minCapacity, maxCapacity int, interval time.Duration) *CapacityController {
+	minCapacity = max(minCapacity, 1)
+	maxCapacity = max(maxCapacity, minCapacity)
+	if interval <= 0 {
+		interval = defaultControllerInterval
+	}
+	stats := cache.Stats()
+	cc := &CapacityController{
+		cache:          cache,
+		minCapacity:    minCapacity,
+		maxCapacity:    maxCapacity,
+		targetHitRatio: defaultTargetHitRatio,
+		headroom:       defaultHeadroom,
+		hits:           stats.Hits,
+		misses:         stats.Misses,
+		stop:           make(chan struct{}),
+		done:           make(chan struct{}),
+	}
+	go cc.run(interval)
+	return cc
+}
+
+// WithTargetHitRatio sets the hit ratio below which a full cache grows. The default is 0.9.
+//
+// Returns:
+//   - The CapacityController, for chaining.
+func (cc *CapacityController) WithTargetHitRatio(ratio float64) *CapacityController {
+	cc.mutex.Lock()
+	defer cc.mutex.Unlock()
+	cc.targetHitRatio = ratio
+	return cc
+}
+
+// WithMemoryLimit sets the process memory, in bytes, the headroom is measured against.
+//
+// Returns:
+//   - The CapacityController, for chaining.
+//
+// Details:
+//   - Zero, the default, uses the runtime memory limit set with debug.SetMemoryLimit or GOMEMLIMIT;
+//     without one, the cache never shrinks for memory.
+func (cc *CapacityController) WithMemoryLimit(bytes uint64) *CapacityController {
+	cc.mutex.Lock()
+	defer cc.mutex.Unlock()
+	cc.memoryLimit = bytes
+	return cc
+}
+
+// WithHeadroom sets the fraction of the memory limit that must stay free. The default is 0.1.
+//
+// Returns:
+//   - The CapacityController, for chaining.
+func (cc *CapacityController) WithHeadroom(fraction float64) *CapacityController {
+	cc.mutex.Lock()
+	defer cc.mutex.Unlock()
+	cc.headroom = fraction
+	return cc
+}
+
+// Adjust runs one adjustment immediately, as the background loop does every interval.
+//
+// Returns:
+//   - The capacity after the adjustment.
+//
+// Details:
+//   - The hit ratio is measured since the previous adjustment.
+//   - A capacity outside the bounds, including an unbounded one, is first brought within them.
+func (cc *CapacityController) Adjust() int {
+	cc.mutex.Lock()
+	defer cc.mutex.Unlock()
+
+	stats := cc.cache.Stats()
+	hits, misses := stats.Hits, stats.Misses
+	// Counters reset with ResetStats restart the measurement
+	if hits >= cc.hits && misses >= cc.misses {
+		hits, misses = hits-cc.hits, misses-cc.misses
+	}
+	cc.hits, cc.misses = stats.Hits, stats.Misses
+
+	current := cc.cache.Capacity()
+	capacity := current
+	if capacity <= 0 {
+		capacity = cc.maxCapacity
+	}
+	capacity = min(max(capacity, cc.minCapacity), cc.maxCapacity)
+	step := max(1, int(float64(capacity)*controllerStep))
+	switch {
+	case cc.underPressure():
+		capacity = max(capacity-step, cc.minCapacity)
+	case hits+misses > 0 && float64(hits)/float64(hits+misses) < cc.targetHitRatio && stats.Len >= capacity:
+		capacity = min(capacity+step, cc.maxCapacity)
+	}
+	if capacity != current {
+		cc.cache.SetCapacity(capacity)
+	}
+	return capacity
+}
+
+// Close stops the controller, leaving the capacity as it is.
+func (cc *CapacityController) Close() {
+	cc.mutex.Lock()
+	select {
+	case <-cc.stop:
+	default:
+		close(cc.stop)
+	}
+	cc.mutex.Unlock()
+	<-cc.done
+}
+
+// run adjusts the capacity every interval until Close.
+func (cc *CapacityController) run(interval time.Duration) {
+	defer close(cc.done)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			cc.Adjust()
+		case <-cc.stop:
+			return
+		}
+	}
+}
+
+// underPressure reports whether the memory used by the process eats into the headroom.
+//
+// Details:
+//   - Must be called with the controller lock held.
+func (cc *CapacityController) underPressure() bool {
+	limit := cc.memoryLimit
+	if limit == 0 {
+		if runtimeLimit := debug.SetMemoryLimit(-1); runtimeLimit != math.MaxInt64 {
+			limit = uint64(runtimeLimit)
+		}
+	}
+	if limit == 0 {
+		return false
+	}
+	return float64(memoryInUse()) > float64(limit)*(1-cc.headroom)
+}
@@ -0,0 +1,77 @@
+package cachify
+
+// WithCopier isolates cached values from callers by copying them on the way in and on the way out.
+//
+// Parameters:
+//   - copier: The function copying values, e.g. DeepCopy, or one switching on the types the
+//     application caches; nil stores and returns shared references again.
+//
+// Returns:
+//   - The LRU cache, for chaining.
+//
+// Details:
+//   - Set and its variants store a copy, so the caller may keep mutating the value it passed.
+//   - Get, GetE, GetAll, Range, Pairs, UpdateFunc, GetWithVersion, Snapshot, and the state accessors
+//     return a copy, so a caller mutating its result cannot corrupt what other readers see.
+//   - Values removed from the cache (GetAndRemove, PopOldest, PopNewest) and values passed to callbacks and event
+//     listeners are not copied.
+//   - Copies are made with the lock held, so an expensive copier lengthens every read.
+func (c *LRU) WithCopier(copier Copier) *LRU {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.copier = copier
+	return c
+}
+
+// view returns a stored value as handed out to callers: decompressed, and copied when a Copier is set.
+//
+// Details:
+//   - Must be called with the read or write lock held.
+func (c *LRU) view(value interface{}) interface{} {
+	value = decompress(value)
+	if c.copier != nil {
+		value = c.copier(value)
+	}
+	return value
+}
+
+// stored returns a value as it is kept in the cache: encoded when serialization is enabled, copied when
+// a Copier is set otherwise, and compressed if eligible.
+//
+// Returns:
+//   - The stored form of the value.
+//   - An error if serialization is enabled and the value cannot be encoded.
+//
+// Details:
+//   - Must be called with the write lock held.
+func (c *LRU) stored(value interface{}) (interface{}, error) {
+	if c.serialize {
+		return c.encode(value)
+	}
+	if c.copier != nil {
+		value = c.copier(value)
+	}
+	return c.compress(value), nil
+}
+
+// prepare converts a value to its stored form and checks it against the maximum value size.
+//
+// Returns:
+//   - The stored form of the value.
+//   - An error if the value cannot be encoded or is too large; any existing entry for the key is removed then.
+//
+// Details:
+//   - Must be called with the write lock held.
+func (c *LRU) prepare(key string, value interface{}) (interface{}, error) {
+	stored, err := c.stored(value)
+	if err == nil {
+		err = c.admit(key, value, stored)
+	}
+	if err != nil {
+		if element, exists := c.cache[key]; exists {
+			c.evict(element)
+		}
+		return nil, err
+	}
+	return stored, nil
+}
@@ -0,0 +1,169 @@
+package cachify
+
+import (
+	"math"
+	"time"
+)
+
+// Increment atomically adds delta to the integer value of a key.
+//
+// Parameters:
+//   - key: The key of the counter.
+//   - delta: The amount to add; may be negative.
+//
+// Returns:
+//   - The new value of the counter.
+//   - ErrNotNumeric if the existing value is not an integer type, or the error of SetE if the new
+//     value is rejected.
+//
+// Details:
+//   - An absent or expired key is created with the value delta and the cache's default expiration.
+//   - Incrementing an existing counter keeps its expiration, so fixed-window rate counters
+//     expire at the end of their window regardless of traffic.
+//   - The counter is stored as an int64 and becomes the most recently used entry.
+func (c *LRU) Increment(key string, delta int64) (int64, error) {
+	key = c.normalizeKey(key)
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry, exists := c.liveEntry(key)
+	if !exists {
+		if _, err := c.write(key, delta); err != nil {
+			return 0, err
+		}
+		c.enforceQuotas(key)
+		c.enforceCapacity(c.cache[key])
+		return delta, nil
+	}
+	value := decompress(entry.value)
+	current, ok := toInt64(value)
+	if f, isFloat := value.(float64); !ok && isFloat && isEncoded(entry.value) && f == math.Trunc(f) {
+		// Codecs such as JSONCodec decode every number as a float64
+		current, ok = int64(f), true
+	}
+	if !ok {
+		return 0, ErrNotNumeric
+	}
+	if err := c.rewrite(entry, current+delta); err != nil {
+		return 0, err
+	}
+	return current + delta, nil
+}
+
+// Decrement atomically subtracts delta from the integer value of a key.
+//
+// Details:
+//   - Equivalent to Increment(key, -delta).
+func (c *LRU) Decrement(key string, delta int64) (int64, error) {
+	return c.Increment(key, -delta)
+}
+
+// IncrementFloat atomically adds delta to the numeric value of a key as a float64.
+//
+// Parameters:
+//   - key: The key of the counter.
+//   - delta: The amount to add; may be negative.
+//
+// Returns:
+//   - The new value of the counter.
+//   - ErrNotNumeric if the existing value is not a numeric type, or the error of SetE if the new
+//     value is rejected.
+//
+// Details:
+//   - Follows the same creation and expiration rules as Increment; the value is stored as a float64.
+func (c *LRU) IncrementFloat(key string, delta float64) (float64, error) {
+	key = c.normalizeKey(key)
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry, exists := c.liveEntry(key)
+	if !exists {
+		if _, err := c.write(key, delta); err != nil {
+			return 0, err
+		}
+		c.enforceQuotas(key)
+		c.enforceCapacity(c.cache[key])
+		return delta, nil
+	}
+	var current float64
+	switch v := decompress(entry.value).(type) {
+	case float64:
+		current = v
+	case float32:
+		current = float64(v)
+	default:
+		n, ok := toInt64(v)
+		if !ok {
+			return 0, ErrNotNumeric
+		}
+		current = float64(n)
+	}
+	if err := c.rewrite(entry, current+delta); err != nil {
+		return 0, err
+	}
+	return current + delta, nil
+}
+
+// rewrite stores a new value for an existing entry through the regular write path, keeping its
+// expiration and any deadline.
+//
+// Returns:
+//   - An error if the value is rejected (see SetE); the entry is removed then.
+//
+// Details:
+//   - Must be called with the write lock held.
+//   - Going through write applies serialization, compression, deduplication, and the replace
+//     callbacks, and advances the version, exactly as Set would.
+func (c *LRU) rewrite(entry *entries, value interface{}) error {
+	expiration, deadline := entry.expiration, entry.deadline
+	entry, err := c.write(entry.key, value)
+	if err != nil {
+		return err
+	}
+	entry.expiration = expiration
+	entry.deadline = deadline
+	return nil
+}
+
+// touchEntry marks an entry as the most recently used without changing its expiration.
+//
+// Details:
+//   - Must be called with the write lock held.
+//   - Leaves the version alone: the value is unchanged, so pending SetIfVersion calls stay valid.
+func (c *LRU) touchEntry(entry *entries) {
+	entry.accessTime = time.Now()
+	c.promote(c.cache[entry.key])
+}
+
+// isEncoded reports whether a stored value is in serialized form.
+func isEncoded(value interface{}) bool {
+	_, ok := value.(encoded)
+	return ok
+}
+
+// toInt64 converts any integer value to an int64.
+func toInt64(value interface{}) (int64, bool) {
+	switch v := value.(type) {
+	case int:
+		return int64(v), true
+	case int8:
+		return int64(v), true
+	case int16:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	case int64:
+		return v, true
+	case uint:
+		return int64(v), true
+	case uint8:
+		return int64(v), true
+	case uint16:
+		return int64(v), true
+	case uint32:
+		return int64(v), true
+	case uint64:
+		return int64(v), true
+	}
+	return 0, false
+}
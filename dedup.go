@@ -0,0 +1,131 @@
+package cachify
+
+import (
+	"bytes"
+
+	"github.com/cespare/xxhash/v2"
+)
+
+// WithDeduplication stores identical serialized values once, shared by every key holding them.
+//
+// Parameters:
+//   - enabled: Whether values written from now on are deduplicated.
+//
+// Returns:
+//   - The LRU cache, for chaining.
+//
+// Details:
+//   - Only values the cache owns in byte form are shared: values encoded by WithSerialization and
+//     values compressed by WithCompression. Other values are stored by reference as usual.
+//   - Each distinct payload is kept in a table with a reference count and released once the last
+//     key holding it is overwritten, removed, evicted, or expired.
+//   - Deduplication costs a hash of the payload per write; it pays off when many keys map to the
+//     same payload. See Deduplicated for the memory saved.
+//   - Disabling it only affects later writes: shared payloads stay shared until released.
+func (c *LRU) WithDeduplication(enabled bool) *LRU {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.dedup = enabled
+	return c
+}
+
+// Deduplicated reports the effect of deduplication.
+//
+// Returns:
+//   - unique: The number of distinct payloads currently shared through the table.
+//   - saved: The number of payload bytes not stored thanks to sharing.
+func (c *LRU) Deduplicated() (unique, saved int) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return len(c.interned), c.dedupSaved
+}
+
+// intern replaces the payload of a stored value with the shared copy of an identical payload, or
+// registers it as the shared copy.
+//
+// Details:
+//   - Must be called with the write lock held, only for a value about to be stored in an entry.
+//   - A hash collision between different payloads leaves the value unshared.
+func (c *LRU) intern(value interface{}) interface{} {
+	if !c.dedup {
+		return value
+	}
+	data, ok := payload(value)
+	if !ok || len(data) == 0 {
+		return value
+	}
+	hash := xxhash.Sum64(data)
+	if shared, exists := c.interned[hash]; exists {
+		if !bytes.Equal(shared.data, data) {
+			return value
+		}
+		shared.refs++
+		c.dedupSaved += len(data)
+		return withPayload(value, shared.data)
+	}
+	if c.interned == nil {
+		c.interned = make(map[uint64]*internedValue)
+	}
+	c.interned[hash] = &internedValue{data: data, refs: 1}
+	return value
+}
+
+// unintern releases the reference a stored value holds on its shared payload, if any.
+//
+// Details:
+//   - Must be called with the write lock held, once for every value leaving an entry.
+func (c *LRU) unintern(value interface{}) {
+	if len(c.interned) == 0 {
+		return
+	}
+	data, ok := payload(value)
+	if !ok || len(data) == 0 {
+		return
+	}
+	hash := xxhash.Sum64(data)
+	shared, exists := c.interned[hash]
+	// Only the shared copy itself holds a reference; an equal payload left unshared does not
+	if !exists || len(shared.data) != len(data) || &shared.data[0] != &data[0] {
+		return
+	}
+	shared.refs--
+	if shared.refs == 0 {
+		delete(c.interned, hash)
+		return
+	}
+	c.dedupSaved -= len(data)
+}
+
+// payload returns the bytes of a value the cache owns in byte form.
+func payload(value interface{}) ([]byte, bool) {
+	switch v := value.(type) {
+	case encoded:
+		switch data := v.data.(type) {
+		case []byte:
+			return data, true
+		case compressed:
+			return data.data, true
+		}
+	case compressed:
+		return v.data, true
+	}
+	return nil, false
+}
+
+// withPayload returns a copy of a value the cache owns in byte form, holding the given bytes instead.
+func withPayload(value interface{}, data []byte) interface{} {
+	switch v := value.(type) {
+	case encoded:
+		if inner, ok := v.data.(compressed); ok {
+			inner.data = data
+			v.data = inner
+		} else {
+			v.data = data
+		}
+		return v
+	case compressed:
+		v.data = data
+		return v
+	}
+	return value
+}
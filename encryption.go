@@ -0,0 +1,103 @@
+package cachify
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+)
+
+// errCiphertextTooShort is returned when sealed data is shorter than its nonce.
+var errCiphertextTooShort = errors.New("cachify: ciphertext too short")
+
+// NewAESGCM creates an AES-GCM cipher for encrypting snapshots and tier values.
+//
+// Parameters:
+//   - key: A 16, 24, or 32 byte key selecting AES-128, AES-192, or AES-256.
+//
+// Returns:
+//   - The AEAD cipher.
+//   - An error if the key length is invalid.
+func NewAESGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// NewEncryptedCodec creates a codec that encrypts the output of another codec.
+//
+// Parameters:
+//   - codec: The codec serializing values, e.g. JSONCodec{}.
+//   - aead: The cipher sealing the serialized bytes, e.g. from NewAESGCM.
+//
+// Returns:
+//   - A pointer to an initialized EncryptedCodec, usable with WithCodec on the cache and on store adapters.
+func NewEncryptedCodec(codec Codec, aead cipher.AEAD) *EncryptedCodec {
+	return &EncryptedCodec{
+		codec: codec,
+		aead:  aead,
+	}
+}
+
+// Marshal encodes a value and seals it with a random nonce.
+func (e *EncryptedCodec) Marshal(value interface{}) ([]byte, error) {
+	data, err := e.codec.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return seal(e.aead, data)
+}
+
+// Unmarshal authenticates and decrypts data, then decodes it into the value pointed to by v.
+func (e *EncryptedCodec) Unmarshal(data []byte, v interface{}) error {
+	plain, err := unseal(e.aead, data)
+	if err != nil {
+		return err
+	}
+	return e.codec.Unmarshal(plain, v)
+}
+
+// WithEncryption seals snapshot files written by SaveToFile and auto-snapshots.
+//
+// Parameters:
+//   - aead: The cipher to use, e.g. from NewAESGCM. Nil disables encryption.
+//
+// Returns:
+//   - The LRU cache, for chaining.
+//
+// Details:
+//   - The whole file is encrypted and authenticated, including keys and metadata; LoadFromFile
+//     fails on files that were tampered with or written with another key.
+func (c *LRU) WithEncryption(aead cipher.AEAD) *LRU {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.aead = aead
+	return c
+}
+
+// cipher returns the cipher sealing snapshot files, if any.
+func (c *LRU) cipher() cipher.AEAD {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.aead
+}
+
+// seal encrypts data with a random nonce, returning the nonce followed by the ciphertext.
+func seal(aead cipher.AEAD, data []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(data)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, data, nil), nil
+}
+
+// unseal reverses seal.
+func unseal(aead cipher.AEAD, data []byte) ([]byte, error) {
+	if len(data) < aead.NonceSize() {
+		return nil, errCiphertextTooShort
+	}
+	nonce, ciphertext := data[:aead.NonceSize()], data[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, nil)
+}
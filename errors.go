@@ -0,0 +1,145 @@
+package cachify
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// ErrNotFound is returned when a key does not exist in the cache.
+	ErrNotFound = errors.New("cachify: key not found")
+
+	// ErrExpired is returned when a key exists but its entry has expired.
+	// The expired entry is removed as part of the call that reports it.
+	ErrExpired = errors.New("cachify: key expired")
+
+	// ErrClosed is returned by the error-returning variants once the cache has been closed.
+	ErrClosed = errors.New("cachify: cache closed")
+
+	// ErrTooLarge is returned when a value exceeds the maximum size accepted by the cache.
+	ErrTooLarge = errors.New("cachify: value too large")
+
+	// ErrNotNumeric is returned by Increment, Decrement and IncrementFloat when the
+	// existing value of a key is not a number.
+	ErrNotNumeric = errors.New("cachify: value is not numeric")
+
+	// ErrNoNodes is returned by a StoreRing that has no node to route a key to.
+	ErrNoNodes = errors.New("cachify: no nodes on the ring")
+
+	// ErrInvalidConfig is matched by the errors reporting a nonsensical configuration.
+	ErrInvalidConfig = errors.New("cachify: invalid configuration")
+
+	// ErrPanic is matched by the PanicError reported when a user callback panics.
+	ErrPanic = errors.New("cachify: callback panicked")
+)
+
+// GetE retrieves the value associated with a given key, reporting why a lookup missed.
+//
+// Parameters:
+//   - key: The key whose value is to be retrieved.
+//
+// Returns:
+//   - The value associated with the key.
+//   - ErrNotFound if the key does not exist, ErrExpired if it has expired,
+//     or ErrClosed if the cache has been closed.
+//
+// Details:
+//   - Behaves exactly like Get, including the buffered read path and the latency histogram; only the
+//     reason for a miss is added.
+func (c *LRU) GetE(key string) (interface{}, error) {
+	if l := c.latency.Load(); l != nil {
+		defer l.get.observe(time.Now())
+	}
+	key = c.normalizeKey(key)
+	if c.IsClosed() {
+		return nil, ErrClosed
+	}
+	if value, ok, served := c.getBuffered(key); served {
+		if !ok {
+			return nil, ErrNotFound
+		}
+		return value, nil
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	element := c.cache[key]
+	if element == nil {
+		c.countNamespaces(key, (*counters).miss)
+		c.access(nil)
+		return nil, ErrNotFound
+	}
+	// The key exists, so access only misses when the entry has expired
+	value, ok := c.access(element)
+	if !ok {
+		return nil, ErrExpired
+	}
+	return value, nil
+}
+
+// SetE inserts or updates a key-value pair in the cache.
+//
+// Returns:
+//   - ErrClosed if the cache has been closed, a *ValueSizeError if the value exceeds
+//     the maximum value size, the codec error if serialization is enabled and the value
+//     cannot be encoded, nil otherwise.
+func (c *LRU) SetE(key string, value interface{}) error {
+	key = c.normalizeKey(key)
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.closed {
+		return ErrClosed
+	}
+	if _, err := c.write(key, value); err != nil {
+		return err
+	}
+	c.enforceQuotas(key)
+	c.enforceCapacity(c.cache[key])
+	return nil
+}
+
+// UpdateE updates the value associated with an existing key.
+//
+// Returns:
+//   - ErrNotFound if the key does not exist, ErrExpired if it has expired,
+//     ErrClosed if the cache has been closed, or a *ValueSizeError if the value exceeds
+//     the maximum value size (the key is then removed).
+func (c *LRU) UpdateE(key string, value interface{}) error {
+	key = c.normalizeKey(key)
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.closed {
+		return ErrClosed
+	}
+	element, exists := c.cache[key]
+	if !exists {
+		return ErrNotFound
+	}
+	if expired(element.Value.(*entries), time.Now()) {
+		c.expire(element)
+		return ErrExpired
+	}
+	_, err := c.write(key, value)
+	return err
+}
+
+// RemoveE deletes a specific key-value pair from the cache.
+//
+// Returns:
+//   - ErrNotFound if the key does not exist, or ErrClosed if the cache has been closed.
+func (c *LRU) RemoveE(key string) error {
+	key = c.normalizeKey(key)
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.closed {
+		return ErrClosed
+	}
+	element, exists := c.cache[key]
+	if !exists {
+		return ErrNotFound
+	}
+	c.evict(element)
+	return nil
+}
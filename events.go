@@ -0,0 +1,75 @@
+package cachify
+
+import (
+	"fmt"
+	"time"
+)
+
+// WithEventListener sets the listener notified of every change to the cache's contents.
+//
+// Parameters:
+//   - listener: The listener, e.g. Exporter.Handle. Nil removes it.
+//
+// Returns:
+//   - The LRU cache, for chaining.
+//
+// Details:
+//   - Writes report EventSet; Remove and other explicit removals report EventRemove; capacity and
+//     quota evictions report EventEvict; evictions by SetCapacity and Resize report EventResize;
+//     expirations report EventExpire; Clear reports one EventClear.
+//   - Replacing the contents wholesale (Restore, LoadFromFile) reports one EventClear for the
+//     discarded entries; the restored ones are not reported.
+//   - The listener runs with the cache lock held; hand events off quickly, as Exporter does.
+func (c *LRU) WithEventListener(listener EventListener) *LRU {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.onEvent = listener
+	return c
+}
+
+// String returns the lowercase name of the operation, e.g. "set".
+func (op EventOp) String() string {
+	switch op {
+	case EventSet:
+		return "set"
+	case EventRemove:
+		return "remove"
+	case EventEvict:
+		return "evict"
+	case EventExpire:
+		return "expire"
+	case EventClear:
+		return "clear"
+	case EventResize:
+		return "resize"
+	default:
+		return fmt.Sprintf("EventOp(%d)", int(op))
+	}
+}
+
+// MarshalText encodes the operation as its name, so events serialize as {"op":"set",...}.
+func (op EventOp) MarshalText() ([]byte, error) {
+	return []byte(op.String()), nil
+}
+
+// UnmarshalText decodes an operation from its name.
+func (op *EventOp) UnmarshalText(text []byte) error {
+	for candidate := EventSet; candidate <= EventResize; candidate++ {
+		if candidate.String() == string(text) {
+			*op = candidate
+			return nil
+		}
+	}
+	return fmt.Errorf("cachify: unknown event op %q", text)
+}
+
+// emit notifies the event listener, if any.
+//
+// Details:
+//   - Must be called with the write lock held.
+func (c *LRU) emit(op EventOp, key string, value interface{}) {
+	if c.onEvent != nil {
+		event := Event{Op: op, Key: key, Value: value, Time: time.Now()}
+		invoke(&c.stats, c.onPanic, "event", key, func() { c.onEvent(event) })
+	}
+}
@@ -0,0 +1,374 @@
+package cachify
+
+import (
+	"container/list"
+	"time"
+)
+
+// expired reports whether an entry has expired at the given time.
+//
+// Parameters:
+//   - entry: The entry to check.
+//   - now: The reference time.
+//
+// Returns:
+//   - true if the entry has an expiration and it lies strictly before now.
+//
+// Details:
+//   - This is the single expiry rule used by every read path, removal path and cleanup sweep.
+//   - The entry's own expiration is authoritative: a zero expiration never expires, so entries
+//     written before expiration was enabled (or while it was disabled) keep living.
+func expired(entry *entries, now time.Time) bool {
+	return !entry.expiration.IsZero() && now.After(entry.expiration)
+}
+
+// expire evicts an expired entry and counts the expiration.
+//
+// Details:
+//   - Must be called with the write lock held.
+func (c *LRU) expire(element *list.Element) {
+	c.stats.expire()
+	c.drop(element, EventExpire)
+}
+
+// OnExpire sets the callback invoked when an entry leaves the cache because its TTL elapsed.
+//
+// Parameters:
+//   - callback: The function to invoke with the key and value of each expired entry, or nil to remove it.
+//
+// Returns:
+//   - The LRU cache, for chaining.
+//
+// Details:
+//   - Unlike the eviction callback, which fires for every removal, this one fires only for expirations,
+//     whether they are found by a read or by the cleanup sweep. Capacity evictions, Remove, and Clear
+//     never invoke it.
+//   - The eviction callback still runs for expired entries, before this one.
+//   - Like the eviction callback, it runs with the write lock held and must not call back into the cache.
+func (c *LRU) OnExpire(callback OnCallback) *LRU {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.onExpire = callback
+	return c
+}
+
+// SetWithExpireCallback inserts or updates a key-value pair with a callback invoked if the entry expires.
+//
+// Parameters:
+//   - key: The key to be added or updated.
+//   - value: The value to be associated with the key.
+//   - callback: The function to invoke when this entry expires. It replaces any callback previously
+//     registered for the key; nil removes it.
+//
+// Details:
+//   - Behaves like Set regarding recency, expiration, and capacity eviction.
+//   - The callback runs after the cache-wide OnExpire callback, under the same conditions.
+//   - A plain Set on the key keeps its callback, so refreshing a value keeps its refresh logic.
+func (c *LRU) SetWithExpireCallback(key string, value interface{}, callback OnCallback) {
+	key = c.normalizeKey(key)
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry := c.set(key, value)
+	if entry == nil {
+		return
+	}
+	entry.onExpire = callback
+	c.enforceQuotas(key)
+	c.enforceCapacity(c.cache[key])
+}
+
+// SetWithDeadline inserts or updates a key-value pair that expires at a given time rather than after
+// the cache-wide expiry, e.g. when the value is a token with its own expiration.
+//
+// Parameters:
+//   - key: The key to be added or updated.
+//   - value: The value to be associated with the key.
+//   - deadline: The time the entry expires. The zero time means it never expires, as with SetPersistent.
+//
+// Details:
+//   - Behaves like Set regarding recency and capacity eviction; a later Set on the key replaces the
+//     deadline with the cache-wide expiry, while Update and Touch keep it.
+//   - A deadline that is not in the future stores nothing and removes any existing entry for the key.
+//   - WithMaxTTL still lowers a deadline too far away, but WithMinTTL never extends one, so an entry
+//     cannot outlive the resource it mirrors.
+//   - Without a cache-wide expiry no background cleanup runs: the entry is then removed when it is
+//     accessed after its deadline, or by PurgeExpired.
+func (c *LRU) SetWithDeadline(key string, value interface{}, deadline time.Time) {
+	key = c.normalizeKey(key)
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	now := time.Now()
+	if !deadline.IsZero() && !deadline.After(now) {
+		if element, exists := c.cache[key]; exists {
+			c.evict(element)
+		}
+		return
+	}
+	entry := c.set(key, value)
+	if entry == nil {
+		return
+	}
+	if !deadline.IsZero() && c.maxTTL > 0 && deadline.After(now.Add(c.maxTTL)) {
+		deadline = now.Add(c.maxTTL)
+	}
+	entry.expiration = deadline
+	entry.persistent = deadline.IsZero()
+	entry.deadline = !deadline.IsZero()
+	c.enforceQuotas(key)
+	c.enforceCapacity(c.cache[key])
+}
+
+// SetPersistent inserts or updates a key-value pair exempt from the cache-wide expiry.
+//
+// Parameters:
+//   - key: The key to be added or updated.
+//   - value: The value to be associated with the key.
+//
+// Details:
+//   - Behaves like Set regarding recency and capacity eviction: the entry never expires, but it can
+//     still be evicted for capacity unless it is pinned.
+//   - The exemption sticks to the key: later writes with Set, Update, or Touch keep it non-expiring,
+//     until it is removed or written with SetWithDeadline.
+//   - Suited to long-lived reference data kept in a cache whose other entries expire.
+func (c *LRU) SetPersistent(key string, value interface{}) {
+	key = c.normalizeKey(key)
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry := c.set(key, value)
+	if entry == nil {
+		return
+	}
+	entry.expiration = time.Time{}
+	entry.persistent = true
+	c.enforceQuotas(key)
+	c.enforceCapacity(c.cache[key])
+}
+
+// expiryOf calculates the expiration time of an existing entry being rewritten or refreshed.
+//
+// Details:
+//   - Must be called with the write lock held. Persistent entries keep never expiring, and entries
+//     written with SetWithDeadline keep their deadline.
+func (c *LRU) expiryOf(entry *entries) time.Time {
+	if entry.persistent {
+		return time.Time{}
+	}
+	if entry.deadline {
+		return entry.expiration
+	}
+	return c.calculateExpiry()
+}
+
+// notifyExpire invokes the cache-wide and per-entry expiration callbacks of an entry, and hands its
+// value to the stale-if-error hook, if any.
+//
+// Details:
+//   - Must be called with the write lock held, before the entry is recycled.
+func (c *LRU) notifyExpire(entry *entries) {
+	if c.onStale != nil {
+		c.onStale(entry.key, c.view(entry.value), entry.expiration)
+	}
+	if c.onExpire == nil && entry.onExpire == nil {
+		return
+	}
+	value := decompress(entry.value)
+	if c.onExpire != nil {
+		invoke(&c.stats, c.onPanic, "expire", entry.key, func() { c.onExpire(entry.key, value) })
+	}
+	if entry.onExpire != nil {
+		invoke(&c.stats, c.onPanic, "expire", entry.key, func() { entry.onExpire(entry.key, value) })
+	}
+}
+
+// liveEntry returns the entry of a key if it exists and has not expired.
+//
+// Details:
+//   - Must be called with the write lock held; an expired entry is evicted.
+func (c *LRU) liveEntry(key string) (*entries, bool) {
+	element, exists := c.cache[key]
+	if !exists {
+		return nil, false
+	}
+	entry := element.Value.(*entries)
+	if expired(entry, time.Now()) {
+		c.expire(element)
+		return nil, false
+	}
+	return entry, true
+}
+
+// cleanupExpired removes all expired entries from the cache.
+//
+// Parameters:
+//   - stop: The cleanup goroutine's stop channel, checked between bounded sweeps.
+//
+// Details:
+//   - Iterates through all items and evicts those that have exceeded their expiration time.
+//   - When a sweep budget is configured, the pass is split into bounded sweeps that each hold
+//     the write lock briefly and resume where the previous one left off.
+func (c *LRU) cleanupExpired(stop chan struct{}) {
+	c.mutex.Lock()
+	maxEntries, maxDuration := c.sweepEntries, c.sweepDuration
+	if maxEntries <= 0 && maxDuration <= 0 {
+		c.purgeExpired()
+		c.mutex.Unlock()
+		return
+	}
+	c.mutex.Unlock()
+	// Sweep in bounded batches, releasing the lock between them so traffic is not frozen
+	for {
+		c.mutex.Lock()
+		_, done := c.sweep(maxEntries, maxDuration)
+		c.mutex.Unlock()
+		if done {
+			return
+		}
+		select {
+		case <-stop:
+			return
+		default:
+		}
+	}
+}
+
+// purgeExpired evicts every expired entry.
+//
+// Returns:
+//   - The number of entries removed.
+//
+// Details:
+//   - Must be called with the write lock held.
+func (c *LRU) purgeExpired() int {
+	removed := 0
+	now := time.Now()
+	for _, element := range c.cache {
+		if expired(element.Value.(*entries), now) {
+			// Entry has expired, evict it from the cache
+			c.expire(element)
+			removed++
+		}
+	}
+	return removed
+}
+
+// sweep examines a bounded number of entries for expiration, resuming from the saved cursor.
+//
+// Parameters:
+//   - maxEntries: The maximum number of entries to examine. Zero or less means no limit.
+//   - maxDuration: The maximum time to spend. Zero or less means no limit.
+//
+// Returns:
+//   - The number of entries removed.
+//   - Whether the sweep reached the end of the list, completing a pass.
+//
+// Details:
+//   - Must be called with the write lock held.
+//   - Walks from the least recently used end; if the cursor entry was removed meanwhile, the pass restarts.
+func (c *LRU) sweep(maxEntries int, maxDuration time.Duration) (removed int, done bool) {
+	element := c.list.Back()
+	if cursor := c.sweepCursor; cursor != nil {
+		if _, ok := c.linked(cursor); ok {
+			element = cursor
+		}
+	}
+	start := time.Now()
+	examined := 0
+	for element != nil {
+		if maxEntries > 0 && examined >= maxEntries {
+			break
+		}
+		if maxDuration > 0 && time.Since(start) >= maxDuration {
+			break
+		}
+		prev := element.Prev()
+		if expired(element.Value.(*entries), start) {
+			c.expire(element)
+			removed++
+		}
+		examined++
+		element = prev
+	}
+	c.sweepCursor = element
+	return removed, element == nil
+}
+
+// startCleanup starts a background goroutine to periodically remove expired entries.
+//
+// Parameters:
+//   - stop: A channel closed to stop the goroutine.
+//   - interval: The time between two cleanup runs.
+//
+// Details:
+//   - Runs a cleanup operation at regular intervals to evict expired items.
+//   - Stops when the given `stop` channel is closed.
+func (c *LRU) startCleanup(stop chan struct{}, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			c.cleanupExpired(stop)
+		case <-stop:
+			return
+		}
+	}
+}
+
+// startJanitor starts, or restarts with the current interval, the background cleanup goroutine.
+//
+// Details:
+//   - Must be called with the write lock held.
+//   - Does nothing for lazy or closed caches, or when expiration is disabled.
+func (c *LRU) startJanitor() {
+	if c.lazy || c.closed || c.expiration <= 0 {
+		return
+	}
+	c.stopJanitor()
+	c.stopCleanup = make(chan struct{})
+	go c.startCleanup(c.stopCleanup, c.cleanupPeriod())
+}
+
+// stopJanitor stops the background cleanup goroutine, if running.
+//
+// Details:
+//   - Must be called with the write lock held.
+func (c *LRU) stopJanitor() {
+	if c.stopCleanup != nil {
+		close(c.stopCleanup)
+		c.stopCleanup = nil
+	}
+}
+
+// cleanupPeriod returns the interval between two background cleanup runs.
+//
+// Details:
+//   - Must be called with the read or write lock held.
+//   - Defaults to half the expiration duration when no interval was configured.
+//   - Never shorter than minCleanupInterval.
+func (c *LRU) cleanupPeriod() time.Duration {
+	if c.cleanupInterval > 0 {
+		return max(c.cleanupInterval, minCleanupInterval)
+	}
+	if c.expiration > 0 {
+		return max(c.expiration/2, minCleanupInterval) // Run cleanup at half the expiration interval
+	}
+	return defaultCleanupInterval
+}
+
+// calculateExpiry calculates the expiration time for a new cache entry.
+//
+// Returns:
+//   - A time.Time value representing the expiration time.
+//
+// Details:
+//   - If no expiration is set, returns the zero value for time.Time.
+//   - The expiration is bounded by WithMinTTL and WithMaxTTL.
+func (c *LRU) calculateExpiry() time.Time {
+	if c.expiration > 0 {
+		return c.clampExpiry(time.Now().Add(c.expiration))
+	}
+	return time.Time{}
+}
@@ -0,0 +1,98 @@
+package cachify
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// NewExporter creates a change-data-capture stream writing events as line-delimited JSON.
+//
+// Parameters:
+//   - writer: The destination, e.g. a file or an audit log. Each record is written with one Write
+//     call, so a message producer (e.g. Kafka) can be adapted as an io.Writer with one message per record.
+//   - buffer: The number of records buffered before new events are dropped. Zero or less uses 1024.
+//
+// Returns:
+//   - A pointer to a running Exporter. Attach it with LRU.WithEventListener(exporter.Handle).
+//
+// Details:
+//   - Records look like {"op":"set","key":"user:1","value":{...},"time":"2024-01-02T15:04:05Z"}.
+//   - Records are written in order by a background goroutine; Close waits for the buffer to drain.
+func NewExporter(writer io.Writer, buffer int) *Exporter {
+	if buffer <= 0 {
+		buffer = defaultExportBuffer
+	}
+	e := &Exporter{
+		writer:  writer,
+		records: make(chan exportRecord, buffer),
+		done:    make(chan struct{}),
+	}
+	go e.run()
+	return e
+}
+
+// WithErrorCallback sets the callback invoked when an event cannot be encoded or written.
+// The failed record is skipped.
+//
+// Returns:
+//   - The Exporter, for chaining.
+func (e *Exporter) WithErrorCallback(callback OnErrorCallback) *Exporter {
+	e.onError.Store(&callback)
+	return e
+}
+
+// Handle encodes an event and queues it for export; it has the EventListener signature.
+//
+// Details:
+//   - The event is encoded before Handle returns, so the record reflects the value at the time of
+//     the change even if the caller mutates it afterwards.
+//   - Handle never blocks: it runs with the cache lock held, so while the buffer is full the event
+//     is dropped and counted in Dropped instead. Events received after Close are dropped silently.
+func (e *Exporter) Handle(event Event) {
+	record, err := json.Marshal(event)
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+	if e.closed {
+		return
+	}
+	select {
+	case e.records <- exportRecord{key: event.Key, data: record, err: err}:
+	default:
+		e.dropped.Add(1)
+	}
+}
+
+// Dropped returns the number of events discarded because the buffer was full.
+func (e *Exporter) Dropped() uint64 {
+	return e.dropped.Load()
+}
+
+// Close stops accepting events and waits until every buffered record has been written.
+func (e *Exporter) Close() error {
+	e.mutex.Lock()
+	if e.closed {
+		e.mutex.Unlock()
+		return nil
+	}
+	e.closed = true
+	close(e.records)
+	e.mutex.Unlock()
+	<-e.done
+	return nil
+}
+
+// run writes buffered records until the channel is closed.
+func (e *Exporter) run() {
+	defer close(e.done)
+	for record := range e.records {
+		err := record.err
+		if err == nil {
+			_, err = e.writer.Write(append(record.data, '\n'))
+		}
+		if err != nil {
+			if onError := e.onError.Load(); onError != nil && *onError != nil {
+				(*onError)(record.key, err)
+			}
+		}
+	}
+}
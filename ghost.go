@@ -0,0 +1,141 @@
+package cachify
+
+import (
+	"container/list"
+)
+
+// WithGhostList remembers the keys of recently evicted entries to measure whether the cache is undersized.
+//
+// Parameters:
+//   - size: The number of evicted keys remembered. Zero or less disables the ghost list.
+//
+// Returns:
+//   - The LRU cache, for chaining.
+//
+// Details:
+//   - Only keys evicted to respect the capacity or a namespace quota are remembered; values are not kept.
+//   - Writing a new key that is still in the ghost list counts as a ghost hit (see GhostHits).
+func (c *LRU) WithGhostList(size int) *LRU {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if size <= 0 {
+		c.ghost = nil
+		return c
+	}
+	c.ghost = &ghostList{
+		size:  size,
+		keys:  list.New(),
+		index: make(map[string]*list.Element, mapHint(size)),
+	}
+	return c
+}
+
+// WithAdaptiveCapacity lets the ghost list resize the cache between bounds.
+//
+// Parameters:
+//   - minCapacity: The smallest capacity the cache shrinks to.
+//   - maxCapacity: The largest capacity the cache grows to.
+//
+// Returns:
+//   - The LRU cache, for chaining.
+//
+// Details:
+//   - Requires WithGhostList; enables a ghost list as large as maxCapacity if none is configured.
+//   - After every capacity's worth of new keys, the capacity grows by 10% when more than 5% of them
+//     were ghost hits, and shrinks by 10% when none were.
+//   - A bounded cache whose capacity lies outside the bounds is resized into them right away, like
+//     SetCapacity; an unbounded cache stays unbounded and is never adapted.
+func (c *LRU) WithAdaptiveCapacity(minCapacity, maxCapacity int) *LRU {
+	c.mutex.RLock()
+	enabled := c.ghost != nil
+	c.mutex.RUnlock()
+	if !enabled {
+		c.WithGhostList(maxCapacity)
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.ghost != nil && minCapacity > 0 && maxCapacity >= minCapacity {
+		c.ghost.minCapacity = minCapacity
+		c.ghost.maxCapacity = maxCapacity
+		if c.capacity > 0 {
+			if capacity := min(max(c.capacity, minCapacity), maxCapacity); capacity != c.capacity {
+				c.resize(capacity, false)
+			}
+		}
+	}
+	return c
+}
+
+// GhostHits returns the number of writes to keys that had recently been evicted for capacity.
+//
+// Returns:
+//   - The ghost hit count, or zero if the ghost list is disabled.
+func (c *LRU) GhostHits() uint64 {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	if c.ghost == nil {
+		return 0
+	}
+	return c.ghost.hits
+}
+
+// Ghosts returns the number of keys currently in the ghost list.
+func (c *LRU) Ghosts() int {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	if c.ghost == nil {
+		return 0
+	}
+	return c.ghost.keys.Len()
+}
+
+// remember adds a key evicted for capacity to the ghost list.
+// It must be called with the write lock held.
+func (c *LRU) remember(key string) {
+	g := c.ghost
+	if g == nil {
+		return
+	}
+	if element, exists := g.index[key]; exists {
+		g.keys.MoveToFront(element)
+		return
+	}
+	g.index[key] = g.keys.PushFront(key)
+	for g.keys.Len() > g.size {
+		delete(g.index, g.keys.Remove(g.keys.Back()).(string))
+	}
+}
+
+// admitGhost records the insertion of a new key, counting a ghost hit if it was recently evicted,
+// and adapts the capacity at the end of each window.
+// It must be called with the write lock held.
+func (c *LRU) admitGhost(key string) {
+	g := c.ghost
+	if g == nil {
+		return
+	}
+	if element, exists := g.index[key]; exists {
+		g.keys.Remove(element)
+		delete(g.index, key)
+		g.hits++
+		g.windowHits++
+	}
+	if g.minCapacity <= 0 || c.capacity <= 0 {
+		return
+	}
+	g.inserts++
+	if g.inserts < c.capacity {
+		return
+	}
+	step := c.capacity / ghostResizeFactor
+	if step < 1 {
+		step = 1
+	}
+	switch {
+	case float64(g.windowHits)/float64(g.inserts) > ghostGrowRatio:
+		c.capacity = min(c.capacity+step, g.maxCapacity)
+	case g.windowHits == 0:
+		c.capacity = max(c.capacity-step, g.minCapacity)
+	}
+	g.inserts, g.windowHits = 0, 0
+}
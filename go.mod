@@ -2,7 +2,11 @@ module github.com/pnguyen215/cachify
 
 go 1.23.1
 
-require github.com/stretchr/testify v1.10.0
+require (
+	github.com/cespare/xxhash/v2 v2.3.0
+	github.com/golang/snappy v0.0.4
+	github.com/stretchr/testify v1.10.0
+)
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
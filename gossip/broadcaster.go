@@ -0,0 +1,383 @@
+// Package gossip provides an embedded, peer-to-peer implementation of cachify.Broadcaster, so several
+// instances can keep their local caches coherent without Redis or NATS.
+//
+// Instances exchange UDP datagrams: each invalidation is sent to a few known peers, which deliver it
+// locally and forward it until its hop budget is spent. Peers are learned from incoming datagrams,
+// so an instance only needs one reachable seed to join.
+//
+// Datagrams are neither authenticated nor encrypted: anyone able to reach the port can invalidate
+// keys and get itself learned as a peer. Listen on a trusted network only, or firewall the port.
+package gossip
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	mathrand "math/rand"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pnguyen215/cachify"
+)
+
+const (
+	// defaultHops is the number of times a message is forwarded unless overridden with WithHops.
+	defaultHops = 3
+
+	// maxDatagram is the largest message accepted, bounded by the UDP payload size.
+	maxDatagram = 65000
+
+	// seenRetention is how long message identifiers are remembered to drop duplicates.
+	seenRetention = time.Minute
+
+	// maxLearned is the number of peers learned from incoming datagrams kept at once; further
+	// senders are not learned until others age out.
+	maxLearned = 1024
+
+	// peerRetention is how long a learned peer is kept after its last datagram.
+	peerRetention = 10 * time.Minute
+)
+
+// ErrClosed is returned by Publish and Join once the broadcaster has been closed.
+var ErrClosed = errors.New("gossip: broadcaster closed")
+
+// Broadcaster is a cachify.Broadcaster gossiping over UDP.
+//
+// Fields:
+//   - conn: The UDP socket used to send and receive messages.
+//   - mutex: A lock protecting the fields below.
+//   - peers: The known peers by address.
+//   - learned: The number of peers learned from incoming datagrams rather than joined.
+//   - seen: The identifiers of recently delivered messages and when they were seen.
+//   - pruned: When seen was last pruned of expired identifiers.
+//   - aged: When peers was last pruned of silent learned peers.
+//   - handlers: The handlers registered by Subscribe.
+//   - fanout: The number of peers each message is sent to. Zero sends to every known peer.
+//   - hops: The number of times a message may be forwarded.
+//   - closed: Whether Close has been called.
+//   - done: Closed when the receive loop exits.
+type Broadcaster struct {
+	conn     *net.UDPConn
+	mutex    sync.Mutex
+	peers    map[string]*member
+	learned  int
+	seen     map[string]time.Time
+	pruned   time.Time
+	aged     time.Time
+	handlers []func(msg cachify.Invalidation)
+	fanout   int
+	hops     int
+	closed   bool
+	done     chan struct{}
+}
+
+// member is a known peer.
+//
+// Fields:
+//   - addr: The peer's address.
+//   - learned: Whether the peer was learned from its datagrams, and so ages out, rather than joined.
+//   - heard: When the peer's last datagram arrived.
+type member struct {
+	addr    *net.UDPAddr
+	learned bool
+	heard   time.Time
+}
+
+// envelope is the datagram exchanged between peers.
+//
+// Fields:
+//   - ID: A random identifier used to drop duplicates.
+//   - Hops: The remaining number of forwards.
+//   - Msg: The invalidation.
+type envelope struct {
+	ID   string               `json:"id"`
+	Hops int                  `json:"hops"`
+	Msg  cachify.Invalidation `json:"msg"`
+}
+
+var _ cachify.Broadcaster = (*Broadcaster)(nil)
+
+// NewBroadcaster listens on a UDP address and joins the given seed peers.
+//
+// Parameters:
+//   - addr: The local address to listen on, e.g. ":7946" or "127.0.0.1:0".
+//   - seeds: Addresses of peers already in the cluster. They learn about this instance from its first message.
+//
+// Returns:
+//   - A pointer to a running Broadcaster sending to every known peer, with 3 hops.
+//   - An error if the address cannot be resolved or bound.
+func NewBroadcaster(addr string, seeds ...string) (*Broadcaster, error) {
+	local, err := net.ResolveUDPAddr("udp", addr)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := net.ListenUDP("udp", local)
+	if err != nil {
+		return nil, err
+	}
+	b := &Broadcaster{
+		conn:  conn,
+		peers: make(map[string]*member),
+		seen:  make(map[string]time.Time),
+		hops:  defaultHops,
+		done:  make(chan struct{}),
+	}
+	if err := b.Join(seeds...); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	go b.receive()
+	return b, nil
+}
+
+// WithFanout sets the number of random peers each message is sent to.
+//
+// Parameters:
+//   - n: The fanout. Zero or less sends to every known peer, which suits small clusters.
+//
+// Returns:
+//   - The Broadcaster, for chaining.
+func (b *Broadcaster) WithFanout(n int) *Broadcaster {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.fanout = max(n, 0)
+	return b
+}
+
+// WithHops sets how many times a message is forwarded by the peers receiving it.
+//
+// Details:
+//   - Incoming messages asking for more forwards are capped at this value.
+//
+// Returns:
+//   - The Broadcaster, for chaining.
+func (b *Broadcaster) WithHops(n int) *Broadcaster {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.hops = max(n, 0)
+	return b
+}
+
+// Addr returns the local address the broadcaster listens on.
+func (b *Broadcaster) Addr() net.Addr {
+	return b.conn.LocalAddr()
+}
+
+// Join adds peers to the membership list. Joined peers never age out, unlike learned ones.
+//
+// Returns:
+//   - An error if an address cannot be resolved; peers before it are kept.
+func (b *Broadcaster) Join(peers ...string) error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if b.closed {
+		return ErrClosed
+	}
+	for _, peer := range peers {
+		addr, err := net.ResolveUDPAddr("udp", peer)
+		if err != nil {
+			return err
+		}
+		if m, exists := b.peers[addr.String()]; exists && m.learned {
+			b.learned--
+		}
+		b.peers[addr.String()] = &member{addr: addr}
+	}
+	return nil
+}
+
+// Peers returns the addresses of the known peers in lexical order.
+func (b *Broadcaster) Peers() []string {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	peers := make([]string, 0, len(b.peers))
+	for peer := range b.peers {
+		peers = append(peers, peer)
+	}
+	sort.Strings(peers)
+	return peers
+}
+
+// Publish delivers an invalidation to the local handlers and gossips it to peers.
+//
+// Details:
+//   - Delivery is best effort: UDP datagrams may be lost, and peers that are down miss the message.
+//   - ctx is only checked before sending.
+func (b *Broadcaster) Publish(ctx context.Context, msg cachify.Invalidation) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	b.mutex.Lock()
+	if b.closed {
+		b.mutex.Unlock()
+		return ErrClosed
+	}
+	hops := b.hops
+	b.mutex.Unlock()
+
+	e := envelope{ID: newID(), Hops: hops, Msg: msg}
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	if len(data) > maxDatagram {
+		return fmt.Errorf("gossip: message of %d bytes exceeds the %d byte datagram limit", len(data), maxDatagram)
+	}
+	b.deliver(e)
+	return b.send(data, nil)
+}
+
+// Subscribe registers a handler invoked for every message, including the ones published locally.
+func (b *Broadcaster) Subscribe(ctx context.Context, handler func(msg cachify.Invalidation)) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if b.closed {
+		return ErrClosed
+	}
+	b.handlers = append(b.handlers, handler)
+	return nil
+}
+
+// Close stops receiving messages and releases the socket.
+func (b *Broadcaster) Close() error {
+	b.mutex.Lock()
+	if b.closed {
+		b.mutex.Unlock()
+		return nil
+	}
+	b.closed = true
+	b.mutex.Unlock()
+	err := b.conn.Close()
+	<-b.done
+	return err
+}
+
+// receive reads datagrams until the socket is closed, delivering and forwarding each new message.
+func (b *Broadcaster) receive() {
+	defer close(b.done)
+	buf := make([]byte, maxDatagram)
+	for {
+		n, from, err := b.conn.ReadFromUDP(buf)
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+			continue
+		}
+		var e envelope
+		if err := json.Unmarshal(buf[:n], &e); err != nil {
+			continue
+		}
+		b.mutex.Lock()
+		b.learn(from)
+		e.Hops = min(e.Hops, b.hops)
+		b.mutex.Unlock()
+		if !b.deliver(e) || e.Hops <= 0 {
+			continue
+		}
+		e.Hops--
+		if data, err := json.Marshal(e); err == nil {
+			_ = b.send(data, from)
+		}
+	}
+}
+
+// learn records the sender of a datagram: its socket is also its listening address, so it becomes
+// a known peer, within the bound on learned peers.
+//
+// Details:
+//   - Must be called with the lock held.
+//   - Learned peers silent for longer than peerRetention are dropped, at most once per minute.
+func (b *Broadcaster) learn(from *net.UDPAddr) {
+	now := time.Now()
+	if now.Sub(b.aged) > time.Minute {
+		for key, m := range b.peers {
+			if m.learned && now.Sub(m.heard) > peerRetention {
+				delete(b.peers, key)
+				b.learned--
+			}
+		}
+		b.aged = now
+	}
+	if m, exists := b.peers[from.String()]; exists {
+		m.heard = now
+		return
+	}
+	if b.learned >= maxLearned {
+		return
+	}
+	b.peers[from.String()] = &member{addr: from, learned: true, heard: now}
+	b.learned++
+}
+
+// deliver hands a message to the handlers unless it was already seen.
+//
+// Returns:
+//   - Whether the message was new.
+func (b *Broadcaster) deliver(e envelope) bool {
+	now := time.Now()
+	b.mutex.Lock()
+	if _, seen := b.seen[e.ID]; seen {
+		b.mutex.Unlock()
+		return false
+	}
+	b.seen[e.ID] = now
+	if now.Sub(b.pruned) > seenRetention {
+		for id, at := range b.seen {
+			if now.Sub(at) > seenRetention {
+				delete(b.seen, id)
+			}
+		}
+		b.pruned = now
+	}
+	handlers := append([]func(cachify.Invalidation){}, b.handlers...)
+	b.mutex.Unlock()
+
+	for _, handler := range handlers {
+		handler(e.Msg)
+	}
+	return true
+}
+
+// send writes a datagram to the fanout peers, skipping the peer it came from.
+//
+// Returns:
+//   - The first write error, after trying every selected peer.
+func (b *Broadcaster) send(data []byte, except *net.UDPAddr) error {
+	b.mutex.Lock()
+	targets := make([]*net.UDPAddr, 0, len(b.peers))
+	for key, m := range b.peers {
+		if except == nil || key != except.String() {
+			targets = append(targets, m.addr)
+		}
+	}
+	fanout := b.fanout
+	b.mutex.Unlock()
+
+	if fanout > 0 && len(targets) > fanout {
+		mathrand.Shuffle(len(targets), func(i, j int) { targets[i], targets[j] = targets[j], targets[i] })
+		targets = targets[:fanout]
+	}
+	var err error
+	for _, target := range targets {
+		if _, e := b.conn.WriteToUDP(data, target); e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+// newID generates a random message identifier.
+func newID() string {
+	buf := make([]byte, 8)
+	_, _ = rand.Read(buf)
+	return hex.EncodeToString(buf)
+}
@@ -0,0 +1,108 @@
+package cachify
+
+import "time"
+
+// WithIndex sets a secondary index over the cached values.
+//
+// Parameters:
+//   - indexer: A function returning the index values of a value, e.g. the user ID of a session.
+//     Nil removes the index.
+//
+// Returns:
+//   - The LRU cache, for chaining.
+//
+// Details:
+//   - The index is maintained as entries are written, removed, evicted, and expired, and entries
+//     already cached are indexed immediately.
+//   - The indexer receives the value as given to Set, and runs with the write lock held: it must be
+//     fast and must not call the cache.
+func (c *LRU) WithIndex(indexer Indexer) *LRU {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.indexer = indexer
+	c.index = nil
+	for element := c.list.Front(); element != nil; element = element.Next() {
+		entry := element.Value.(*entries)
+		entry.indexed = nil
+		c.reindex(entry, c.view(entry.value))
+	}
+	return c
+}
+
+// GetByIndex retrieves every entry whose index values include the given one.
+//
+// Parameters:
+//   - indexValue: The index value to look up.
+//
+// Returns:
+//   - A map of the matching keys and values; empty if none match or no index is set.
+//
+// Details:
+//   - Runs in O(matching entries) using the index, not a full scan.
+//   - Like GetAll, it does not modify the order of items; expired entries are skipped.
+func (c *LRU) GetByIndex(indexValue string) map[string]interface{} {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	keys := c.index[indexValue]
+	matches := make(map[string]interface{}, len(keys))
+	now := time.Now()
+	for key := range keys {
+		if element, exists := c.cache[key]; exists {
+			entry := element.Value.(*entries)
+			if !expired(entry, now) {
+				matches[key] = c.view(entry.value)
+			}
+		}
+	}
+	return matches
+}
+
+// reindex replaces the index values and value hash of an entry with those of its new value.
+//
+// Details:
+//   - Must be called with the write lock held.
+func (c *LRU) reindex(entry *entries, value interface{}) {
+	c.unindex(entry)
+	if c.hashValues {
+		c.rehash(entry, value)
+	}
+	if c.indexer == nil {
+		return
+	}
+	var indexed []string
+	invoke(&c.stats, c.onPanic, "index", entry.key, func() { indexed = c.indexer(value) })
+	if len(indexed) == 0 {
+		return
+	}
+	entry.indexed = append([]string(nil), indexed...)
+	if c.index == nil {
+		c.index = make(map[string]map[string]struct{})
+	}
+	for _, v := range entry.indexed {
+		keys, exists := c.index[v]
+		if !exists {
+			keys = make(map[string]struct{})
+			c.index[v] = keys
+		}
+		keys[entry.key] = struct{}{}
+	}
+}
+
+// unindex removes an entry's key from the index of each of its index values and from the value-hash index.
+//
+// Details:
+//   - Must be called with the write lock held.
+//   - Drops index values and hashes that no longer match any key.
+func (c *LRU) unindex(entry *entries) {
+	c.unhash(entry)
+	for _, v := range entry.indexed {
+		if keys, exists := c.index[v]; exists {
+			delete(keys, entry.key)
+			if len(keys) == 0 {
+				delete(c.index, v)
+			}
+		}
+	}
+	entry.indexed = nil
+}
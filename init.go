@@ -8,8 +8,8 @@ func NewEntries() *entries {
 	return &entries{}
 }
 
-func NewState() *state {
-	return &state{
+func NewState() *State {
+	return &State{
 		accessTime: time.Now(),
 	}
 }
@@ -41,38 +41,76 @@ func (c *entries) Expiration() time.Time {
 	return c.expiration
 }
 
-func (l *state) WithKey(value string) *state {
+func (l *State) WithKey(value string) *State {
 	l.key = value
 	return l
 }
 
-func (l *state) WithValue(value interface{}) *state {
+func (l *State) WithValue(value interface{}) *State {
 	l.value = value
 	return l
 }
 
-func (l *state) WithAccessTime(value time.Time) *state {
+func (l *State) WithAccessTime(value time.Time) *State {
 	l.accessTime = value
 	return l
 }
 
-func (l *state) WithExpiration(value time.Time) *state {
+func (l *State) WithExpiration(value time.Time) *State {
 	l.expiration = value
 	return l
 }
 
-func (l *state) Key() string {
+func (l *State) WithAccessCount(value uint64) *State {
+	l.accessCount = value
+	return l
+}
+
+func (l *State) WithMeta(value map[string]string) *State {
+	l.meta = value
+	return l
+}
+
+func (l *State) WithVersion(value uint64) *State {
+	l.version = value
+	return l
+}
+
+func (l *State) Key() string {
 	return l.key
 }
 
-func (l *state) Value() interface{} {
+func (l *State) Value() interface{} {
 	return l.value
 }
 
-func (l *state) Expiration() time.Time {
+func (l *State) Expiration() time.Time {
 	return l.expiration
 }
 
-func (l *state) AccessTime() time.Time {
+func (l *State) AccessTime() time.Time {
 	return l.accessTime
 }
+
+func (l *State) AccessCount() uint64 {
+	return l.accessCount
+}
+
+func (l *State) Meta() map[string]string {
+	return l.meta
+}
+
+func (l *State) Version() uint64 {
+	return l.version
+}
+
+// Remaining returns the time left until the entry expires.
+//
+// Returns:
+//   - The remaining duration, negative if the entry has already expired, or 0 if it never expires.
+func (l *State) Remaining() time.Duration {
+	if l.expiration.IsZero() {
+		return 0
+	}
+	return time.Until(l.expiration)
+}
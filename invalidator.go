@@ -0,0 +1,121 @@
+package cachify
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+)
+
+// NewInvalidator creates an invalidation-broadcasting decorator over an LRU cache.
+//
+// Parameters:
+//   - cache: The in-memory LRU cache local to this instance.
+//   - broadcaster: The transport shared by all instances.
+//
+// Returns:
+//   - A pointer to an initialized Invalidator.
+//   - An error if subscribing to the broadcaster fails.
+//
+// Details:
+//   - Messages published by this instance are ignored on receipt; peers remove the listed keys
+//     from their local caches without re-publishing.
+func NewInvalidator(cache *LRU, broadcaster Broadcaster) (*Invalidator, error) {
+	i := &Invalidator{
+		cache:       cache,
+		broadcaster: broadcaster,
+		origin:      newOrigin(),
+	}
+	if err := broadcaster.Subscribe(context.Background(), i.receive); err != nil {
+		return nil, err
+	}
+	return i, nil
+}
+
+// WithErrorCallback sets the callback invoked when publishing an invalidation fails.
+//
+// Returns:
+//   - The Invalidator, for chaining.
+func (i *Invalidator) WithErrorCallback(callback OnErrorCallback) *Invalidator {
+	i.onError = callback
+	return i
+}
+
+// Cache returns the decorated in-memory cache.
+func (i *Invalidator) Cache() *LRU {
+	return i.cache
+}
+
+// Origin returns the unique identifier of this instance.
+func (i *Invalidator) Origin() string {
+	return i.origin
+}
+
+// Get retrieves the value associated with a given key from the local cache.
+func (i *Invalidator) Get(key string) (value interface{}, ok bool) {
+	return i.cache.Get(key)
+}
+
+// Set inserts or updates a key-value pair locally and tells peers to drop their copies.
+func (i *Invalidator) Set(key string, value interface{}) {
+	i.cache.Set(key, value)
+	i.Invalidate(key)
+}
+
+// Update updates an existing key locally and tells peers to drop their copies.
+func (i *Invalidator) Update(key string, value interface{}) {
+	i.cache.Update(key, value)
+	i.Invalidate(key)
+}
+
+// Remove deletes a key locally and tells peers to drop their copies.
+func (i *Invalidator) Remove(key string) {
+	i.cache.Remove(key)
+	i.Invalidate(key)
+}
+
+// Invalidate broadcasts a group of keys so every peer removes them from its local cache.
+//
+// Parameters:
+//   - keys: The keys to invalidate on peers. The local cache is not modified.
+//
+// Details:
+//   - Publish errors are reported to the error callback with the keys joined by commas.
+func (i *Invalidator) Invalidate(keys ...string) {
+	if len(keys) == 0 {
+		return
+	}
+	if err := i.InvalidateContext(context.Background(), keys...); err != nil && i.onError != nil {
+		i.onError(strings.Join(keys, ","), err)
+	}
+}
+
+// InvalidateContext broadcasts a group of keys so every peer removes them from its local cache.
+//
+// Returns:
+//   - An error if publishing fails.
+func (i *Invalidator) InvalidateContext(ctx context.Context, keys ...string) error {
+	return i.broadcaster.Publish(ctx, Invalidation{Origin: i.origin, Keys: keys})
+}
+
+// Close closes the underlying broadcaster.
+func (i *Invalidator) Close() error {
+	return i.broadcaster.Close()
+}
+
+// receive drops the keys of an invalidation published by a peer.
+func (i *Invalidator) receive(msg Invalidation) {
+	if msg.Origin == i.origin {
+		return
+	}
+	for _, key := range msg.Keys {
+		i.cache.Remove(key)
+	}
+}
+
+// newOrigin generates a random instance identifier.
+func newOrigin() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,75 @@
+package cachify
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Key builds a composite cache key from parts, e.g. Key("user", 42, "profile") returns "user:42:profile".
+//
+// Parameters:
+//   - parts: The key parts. Strings, []byte, integers, floats, and booleans are formatted without fmt;
+//     other values use their String method or fmt.Sprint.
+//
+// Returns:
+//   - The parts joined with ':'.
+//
+// Details:
+//   - ':' and '\' inside a part are escaped with '\', so a separator inside a part never shifts the
+//     boundaries between parts (Key("a:b", "c") differs from Key("a", "b:c")). Two part lists thus give
+//     the same key only when their parts format to the same strings.
+//   - Parts are not typed and an empty list formats like one empty part: Key("1", 1) equals Key(1, "1"),
+//     and Key() equals Key(""). Keep each position to one type when that matters.
+//   - Key("user", 42) + ":" prefixes every longer key with the same leading parts, which suits RemoveByPrefix.
+func Key(parts ...interface{}) string {
+	var b strings.Builder
+	b.Grow(16 * len(parts))
+	var scratch [32]byte
+	for i, part := range parts {
+		if i > 0 {
+			b.WriteByte(keySeparator)
+		}
+		switch v := part.(type) {
+		case string:
+			writeKeyPart(&b, v)
+		case []byte:
+			writeKeyPart(&b, string(v))
+		case int:
+			b.Write(strconv.AppendInt(scratch[:0], int64(v), 10))
+		case int64:
+			b.Write(strconv.AppendInt(scratch[:0], v, 10))
+		case int32:
+			b.Write(strconv.AppendInt(scratch[:0], int64(v), 10))
+		case uint:
+			b.Write(strconv.AppendUint(scratch[:0], uint64(v), 10))
+		case uint64:
+			b.Write(strconv.AppendUint(scratch[:0], v, 10))
+		case uint32:
+			b.Write(strconv.AppendUint(scratch[:0], uint64(v), 10))
+		case float64:
+			b.Write(strconv.AppendFloat(scratch[:0], v, 'g', -1, 64))
+		case bool:
+			b.Write(strconv.AppendBool(scratch[:0], v))
+		case fmt.Stringer:
+			writeKeyPart(&b, v.String())
+		default:
+			writeKeyPart(&b, fmt.Sprint(v))
+		}
+	}
+	return b.String()
+}
+
+// writeKeyPart writes a key part, escaping the separator and the escape character.
+func writeKeyPart(b *strings.Builder, part string) {
+	if !strings.ContainsAny(part, keyEscapes) {
+		b.WriteString(part)
+		return
+	}
+	for i := 0; i < len(part); i++ {
+		if c := part[i]; c == keySeparator || c == keyEscape {
+			b.WriteByte(keyEscape)
+		}
+		b.WriteByte(part[i])
+	}
+}
@@ -0,0 +1,46 @@
+package cachify
+
+import (
+	"strings"
+
+	"github.com/cespare/xxhash/v2"
+)
+
+// WithKeyTransform sets a function canonicalizing every key passed to the cache.
+//
+// Parameters:
+//   - transform: An idempotent function such as strings.ToLower or TrimLower. Nil disables it.
+//
+// Returns:
+//   - The LRU cache, for chaining.
+//
+// Details:
+//   - Applies to every method taking a single key; prefixes, tags, and []byte keys are used as given.
+//   - Configure it before the cache is shared: keys already stored are not transformed.
+func (c *LRU) WithKeyTransform(transform KeyTransform) *LRU {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.keyTransform = transform
+	return c
+}
+
+// TrimLower is a KeyTransform that trims surrounding whitespace and lowercases keys.
+func TrimLower(key string) string {
+	return strings.ToLower(strings.TrimSpace(key))
+}
+
+// DefaultHasher hashes keys with xxHash64, a fast hash with a good distribution.
+func DefaultHasher(key string) uint64 {
+	return xxhash.Sum64String(key)
+}
+
+// normalizeKey applies the key transform, if any.
+//
+// Details:
+//   - Called before the lock is taken; the transform is set at configuration time.
+func (c *LRU) normalizeKey(key string) string {
+	if c.keyTransform == nil {
+		return key
+	}
+	return c.keyTransform(key)
+}
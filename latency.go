@@ -0,0 +1,98 @@
+package cachify
+
+import (
+	"sort"
+	"time"
+)
+
+// WithLatencyTracking enables or disables latency histograms for Get, Set, and Load.
+//
+// Parameters:
+//   - enabled: Whether latencies are recorded. Disabling discards the recorded histograms.
+//
+// Returns:
+//   - The LRU cache, for chaining.
+//
+// Details:
+//   - Latencies include time spent waiting for the lock, so a shifting distribution reveals contention.
+//   - Load latencies are recorded by a Loading cache wrapping this LRU, around each Loader call.
+//   - The histograms are reported in Stats().Latency.
+func (c *LRU) WithLatencyTracking(enabled bool) *LRU {
+	if !enabled {
+		c.latency.Store(nil)
+		return c
+	}
+	c.latency.CompareAndSwap(nil, &latencies{})
+	return c
+}
+
+// IsLatencyTracking checks whether latency histograms are being recorded.
+func (c *LRU) IsLatencyTracking() bool {
+	return c.latency.Load() != nil
+}
+
+// Mean returns the average observed duration, or zero if there were no observations.
+func (h Histogram) Mean() time.Duration {
+	if h.Count == 0 {
+		return 0
+	}
+	return h.Sum / time.Duration(h.Count)
+}
+
+// Quantile returns an upper estimate of the q-th quantile of the observed durations.
+//
+// Parameters:
+//   - q: The quantile, between 0 and 1 (e.g. 0.99).
+//
+// Returns:
+//   - The upper bound of the bucket holding the quantile, or zero if there were no observations.
+//     Quantiles falling in the overflow bucket report the largest bound.
+func (h Histogram) Quantile(q float64) time.Duration {
+	if h.Count == 0 || len(h.Bounds) == 0 {
+		return 0
+	}
+	rank := uint64(q * float64(h.Count))
+	if rank >= h.Count {
+		rank = h.Count - 1
+	}
+	var seen uint64
+	for i, count := range h.Counts {
+		seen += count
+		if seen > rank && i < len(h.Bounds) {
+			return h.Bounds[i]
+		}
+	}
+	return h.Bounds[len(h.Bounds)-1]
+}
+
+// observe records the time elapsed since start.
+func (h *histogram) observe(start time.Time) {
+	d := time.Since(start)
+	i := sort.Search(len(latencyBounds), func(i int) bool { return latencyBounds[i] >= d })
+	h.buckets[i].Add(1)
+	h.count.Add(1)
+	h.sum.Add(int64(d))
+}
+
+// snapshot copies the histogram into a Histogram value.
+func (h *histogram) snapshot() Histogram {
+	counts := make([]uint64, len(h.buckets))
+	for i := range h.buckets {
+		counts[i] = h.buckets[i].Load()
+	}
+	return Histogram{
+		Bounds: append([]time.Duration(nil), latencyBounds[:]...),
+		Counts: counts,
+		Count:  h.count.Load(),
+		Sum:    time.Duration(h.sum.Load()),
+	}
+}
+
+// snapshot copies every histogram into a Latency value.
+func (l *latencies) snapshot() *Latency {
+	return &Latency{
+		Get:  l.get.snapshot(),
+		Set:  l.set.snapshot(),
+		Load: l.load.snapshot(),
+	}
+}
@@ -0,0 +1,243 @@
+package cachify
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// NewLoading creates a new read-through cache that fills misses through a Loader.
+//
+// Parameters:
+//   - cache: The in-memory LRU cache holding loaded values.
+//   - loader: The function invoked to fetch keys missing from the cache.
+//
+// Returns:
+//   - A pointer to an initialized Loading cache.
+func NewLoading(cache *LRU, loader Loader) *Loading {
+	return &Loading{
+		cache:       cache,
+		loader:      loader,
+		concurrency: defaultPreloadConcurrency,
+		attempts:    1,
+		calls:       make(map[string]*call),
+	}
+}
+
+// WithConcurrency sets the maximum number of Loader calls Preload runs at once.
+//
+// Parameters:
+//   - n: The concurrency limit. Values less than 1 are treated as 1.
+//
+// Returns:
+//   - The Loading cache, for chaining.
+func (l *Loading) WithConcurrency(n int) *Loading {
+	if n < 1 {
+		n = 1
+	}
+	l.concurrency = n
+	return l
+}
+
+// Cache returns the underlying LRU cache.
+func (l *Loading) Cache() *LRU {
+	return l.cache
+}
+
+// Get retrieves the value associated with a given key, loading it on a miss.
+//
+// Parameters:
+//   - ctx: The context passed to the Loader.
+//   - key: The key whose value is to be retrieved.
+//
+// Returns:
+//   - The cached or loaded value.
+//   - An error if the Loader failed. Failed loads are not cached.
+//     A panicking Loader is recovered and reported as a *PanicError (see LRU.WithPanicCallback).
+//
+// Details:
+//   - Concurrent misses on the same key wait for a single Loader call and share its result.
+//   - With early expiration enabled, a hit close to its expiry may be reloaded (see WithEarlyExpiration).
+//   - With stale-if-error enabled, a failed load returns the last known value instead of the error
+//     (see WithStaleIfError); use GetStale to tell such values apart.
+func (l *Loading) Get(ctx context.Context, key string) (interface{}, error) {
+	value, stale, err := l.GetStale(ctx, key)
+	if stale {
+		return value, nil
+	}
+	return value, err
+}
+
+// Preload fetches the given keys through the Loader and populates the cache, typically at startup.
+//
+// Parameters:
+//   - ctx: The context passed to the Loader. Once it is done, keys not yet started fail with its error.
+//   - keys: The keys to warm. Keys cached with a live value are skipped; expired ones are reloaded.
+//
+// Returns:
+//   - The errors of the keys that could not be loaded, keyed by cache key, or nil if every key was loaded.
+//
+// Details:
+//   - At most `concurrency` Loader calls run at once (see WithConcurrency).
+func (l *Loading) Preload(ctx context.Context, keys []string) map[string]error {
+	var (
+		wg     sync.WaitGroup
+		mutex  sync.Mutex
+		errs   map[string]error
+		tokens = make(chan struct{}, l.concurrency)
+	)
+	fail := func(key string, err error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		if errs == nil {
+			errs = make(map[string]error)
+		}
+		errs[key] = err
+	}
+	for _, key := range keys {
+		// Contains also reports expired entries not yet swept, which are the ones most in need of warming
+		if l.cache.Version(key) != 0 {
+			continue
+		}
+		select {
+		case tokens <- struct{}{}:
+		case <-ctx.Done():
+			fail(key, ctx.Err())
+			continue
+		}
+		wg.Add(1)
+		go func(key string) {
+			defer wg.Done()
+			defer func() { <-tokens }()
+			if _, err := l.load(ctx, key); err != nil {
+				fail(key, err)
+			}
+		}(key)
+	}
+	wg.Wait()
+	return errs
+}
+
+// WithOwners makes each key loaded only by the instance owning it, groupcache style.
+//
+// Parameters:
+//   - self: The name of this instance on the ring.
+//   - owners: A ring of every instance, including this one, whose Stores fetch a key from the
+//     instance owning it (e.g. remote.NewPeer). Nil disables ownership.
+//
+// Returns:
+//   - The Loading cache, for chaining.
+//
+// Details:
+//   - On a miss for a key owned by a peer, the value is fetched from the owner, which loads it at most
+//     once for the whole cluster; the result is then cached locally as well.
+//   - If the owner reports the key absent, the miss returns ErrNotFound without calling the local
+//     Loader. If the owner cannot be reached or reports any other error, the key is loaded locally instead.
+//   - Owners serve peers through Fill, so that a request from a peer is never forwarded again.
+func (l *Loading) WithOwners(self string, owners *StoreRing) *Loading {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.self = self
+	l.owners = owners
+	if l.fetches == nil {
+		l.fetches = make(map[string]*call)
+	}
+	return l
+}
+
+// Fill retrieves the value of a key, loading it locally on a miss even if another instance owns it.
+//
+// Parameters:
+//   - ctx: The context passed to the Loader.
+//   - key: The key whose value is to be retrieved.
+//
+// Returns:
+//   - The cached or loaded value, or an error if the Loader failed. ErrNotFound means the key is known
+//     to be absent, either from the negative filter or because the Loader returned it.
+//
+// Details:
+//   - This is the entry point for peers fetching a key they do not own; see WithOwners.
+func (l *Loading) Fill(ctx context.Context, key string) (interface{}, error) {
+	if value, ok := l.cache.Get(key); ok {
+		return value, nil
+	}
+	if l.knownAbsent(l.cache.normalizeKey(key)) {
+		return nil, ErrNotFound
+	}
+	return l.loadLocal(ctx, key)
+}
+
+// load fetches a key from its owner when ownership is enabled and another instance owns it,
+// and invokes the Loader otherwise.
+func (l *Loading) load(ctx context.Context, key string) (interface{}, error) {
+	l.mutex.Lock()
+	self, owners := l.self, l.owners
+	l.mutex.Unlock()
+	if owners != nil {
+		if owner, store, ok := owners.Pick(key); ok && owner != self {
+			value, err := l.share(ctx, l.fetches, key, func() (interface{}, error) {
+				value, found, err := store.Get(ctx, key)
+				if err != nil {
+					return nil, err
+				}
+				if !found {
+					l.markAbsent(l.cache.normalizeKey(key), ErrNotFound)
+					return nil, ErrNotFound
+				}
+				l.cache.Set(key, value)
+				l.dropStale(key)
+				return value, nil
+			})
+			// The owner knows the key is absent: loading it here would only repeat its Loader call
+			if err == nil || errors.Is(err, ErrNotFound) {
+				return value, err
+			}
+		}
+	}
+	return l.loadLocal(ctx, key)
+}
+
+// loadLocal invokes the Loader for a key, sharing the call with concurrent callers, and caches the result.
+func (l *Loading) loadLocal(ctx context.Context, key string) (interface{}, error) {
+	return l.share(ctx, l.calls, key, func() (interface{}, error) {
+		start := time.Now()
+		value, err := l.callLoader(ctx, key)
+		if latency := l.cache.latency.Load(); latency != nil {
+			latency.load.observe(start)
+		}
+		if err == nil {
+			l.cache.setLoaded(key, value, time.Since(start))
+			l.dropStale(key)
+		} else {
+			l.markAbsent(l.cache.normalizeKey(key), err)
+		}
+		return value, err
+	})
+}
+
+// share runs fn for a key unless a call for the key is already in flight in calls, in which case
+// it waits for that call and returns its result.
+func (l *Loading) share(ctx context.Context, calls map[string]*call, key string, fn func() (interface{}, error)) (interface{}, error) {
+	l.mutex.Lock()
+	if c, ok := calls[key]; ok {
+		l.mutex.Unlock()
+		select {
+		case <-c.done:
+			return c.value, c.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	c := &call{done: make(chan struct{})}
+	calls[key] = c
+	l.mutex.Unlock()
+
+	c.value, c.err = fn()
+
+	l.mutex.Lock()
+	delete(calls, key)
+	l.mutex.Unlock()
+	close(c.done)
+	return c.value, c.err
+}
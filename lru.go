@@ -2,13 +2,15 @@ package cachify
 
 import (
 	"container/list"
+	"reflect"
+	"sort"
 	"time"
 )
 
 // NewLRU creates a new LRU cache with the specified capacity.
 //
 // Parameters:
-//   - capacity: The maximum number of items the cache can hold.
+//   - capacity: The maximum number of items the cache can hold. Zero or less means unbounded.
 //
 // Returns:
 //   - A pointer to an initialized LRU cache.
@@ -17,10 +19,12 @@ import (
 //   - The cache uses a combination of a map and a doubly linked list for efficient
 //     O(1) insertion, deletion, and lookup operations.
 //   - Items are evicted based on the "least recently used" policy when the capacity is exceeded.
+//   - An unbounded cache never evicts for capacity; entries leave only by removal or expiration.
+//   - The key map is sized for the capacity up front (up to 65536 entries) to avoid rehashing while the cache warms up.
 func NewLRU(capacity int) *LRU {
 	return &LRU{
 		capacity: capacity,
-		cache:    make(map[string]*list.Element),
+		cache:    make(map[string]*list.Element, mapHint(capacity)),
 		list:     list.New(),
 	}
 }
@@ -55,10 +59,28 @@ func NewLRUCallback(capacity int, callback OnCallback) *LRU {
 //   - Starts a background goroutine to periodically remove expired items.
 func NewLRUExpires(capacity int, expiry time.Duration) *LRU {
 	c := NewLRU(capacity)
+	// Enabling expiry starts a background goroutine for periodic cache cleanup
+	c.SetExpiry(expiry)
+	return c
+}
+
+// NewLRUExpiresLazy creates a new LRU cache with a time-to-live for entries and no background cleanup.
+//
+// Parameters:
+//   - capacity: The maximum number of items the cache can hold.
+//   - expiry: The expiration duration for each cache entry.
+//
+// Returns:
+//   - A pointer to an initialized LRU cache.
+//
+// Details:
+//   - Expired entries are only removed lazily when they are accessed, when they become
+//     eviction victims, or when PurgeExpired is called.
+//   - Suited to serverless and short-lived processes that should not run a ticker per cache.
+func NewLRUExpiresLazy(capacity int, expiry time.Duration) *LRU {
+	c := NewLRU(capacity)
+	c.lazy = true
 	c.SetExpiry(expiry)
-	c.stopCleanup = make(chan struct{})
-	// Start a background goroutine for periodic cache cleanup
-	go c.startCleanup()
 	return c
 }
 
@@ -72,24 +94,25 @@ func NewLRUExpires(capacity int, expiry time.Duration) *LRU {
 //   - A boolean indicating whether the key exists.
 //
 // Details:
+//   - Uses write locking because a read updates the recency order and the access time.
 //   - Moves the accessed item to the front of the list, marking it as most recently used.
 //   - Evicts the item if it is expired (when expiration is enabled).
+//   - With WithBufferedAccess, hits take only the read lock and the move to the front is deferred.
 func (c *LRU) Get(key string) (value interface{}, ok bool) {
-	c.mutex.RLock()
-	defer c.mutex.RUnlock()
-
-	if element, exists := c.cache[key]; exists {
-		// Check if the entry has expired
-		if c.expiration > 0 && time.Now().After(element.Value.(*entries).expiration) {
-			// If the entry has expired, evict it from the cache
-			c.evict(element)
-			return nil, false
-		}
-		// Move the accessed element to the front of the list (most recently used)
-		c.list.MoveToFront(element)
-		return element.Value.(*entries).value, true
+	if l := c.latency.Load(); l != nil {
+		defer l.get.observe(time.Now())
 	}
-	return nil, false
+	key = c.normalizeKey(key)
+	if value, ok, served := c.getBuffered(key); served {
+		return value, ok
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	element := c.cache[key]
+	if element == nil {
+		c.countNamespaces(key, (*counters).miss)
+	}
+	return c.access(element)
 }
 
 // GetAll retrieves all key-value pairs currently in the cache.
@@ -106,11 +129,69 @@ func (c *LRU) GetAll() map[string]interface{} {
 	allEntries := make(map[string]interface{})
 	for _, element := range c.cache {
 		entry := element.Value.(*entries)
-		allEntries[entry.key] = entry.value
+		allEntries[entry.key] = c.view(entry.value)
 	}
 	return allEntries
 }
 
+// Range calls a function for each entry in order from most to least recently used.
+//
+// Parameters:
+//   - fn: A function receiving each key and value. Returning false stops the iteration.
+//
+// Details:
+//   - Holds the read lock for the whole iteration and does not modify the order of items,
+//     so fn sees a consistent view but must not call methods that modify the cache.
+//   - Unlike GetAll, no intermediate copy is made, so stopping early is cheap.
+func (c *LRU) Range(fn func(key string, value interface{}) bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	for element := c.list.Front(); element != nil; element = element.Next() {
+		entry := element.Value.(*entries)
+		if !fn(entry.key, c.view(entry.value)) {
+			return
+		}
+	}
+}
+
+// Keys returns all keys ordered from most to least recently used.
+//
+// Returns:
+//   - A slice of keys; the first element is the most recently used key.
+//
+// Details:
+//   - Does not modify the order of items in the cache.
+func (c *LRU) Keys() []string {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	keys := make([]string, 0, len(c.cache))
+	for element := c.list.Front(); element != nil; element = element.Next() {
+		keys = append(keys, element.Value.(*entries).key)
+	}
+	return keys
+}
+
+// Entries returns the metadata of all entries ordered from most to least recently used.
+//
+// Returns:
+//   - A slice of `State` objects; the first element is the most recently used entry
+//     and the last one is the next eviction victim.
+//
+// Details:
+//   - Does not modify the order of items in the cache.
+func (c *LRU) Entries() []State {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	snapshot := make([]State, 0, len(c.cache))
+	for element := c.list.Front(); element != nil; element = element.Next() {
+		snapshot = append(snapshot, *c.stateOf(element.Value.(*entries)))
+	}
+	return snapshot
+}
+
 // Pairs retrieves the least recently used key-value pair without removing it.
 //
 // Returns:
@@ -123,7 +204,7 @@ func (c *LRU) Pairs() (key string, value interface{}, ok bool) {
 	oldest := c.list.Back()
 	if oldest != nil {
 		entry := oldest.Value.(*entries)
-		return entry.key, entry.value, true
+		return entry.key, c.view(entry.value), true
 	}
 	return "", nil, false
 }
@@ -139,33 +220,46 @@ func (c *LRU) Pairs() (key string, value interface{}, ok bool) {
 //   - If the key does not exist and the cache is full, evicts the least recently used item.
 //   - The expiration time is reset or initialized based on the cache's expiration setting.
 func (c *LRU) Set(key string, value interface{}) {
+	if l := c.latency.Load(); l != nil {
+		defer l.set.observe(time.Now())
+	}
+	key = c.normalizeKey(key)
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.set(key, value)
+	c.enforceQuotas(key)
+	c.enforceCapacity(c.cache[key])
+}
+
+// Add inserts a key-value pair only if the key is not already present.
+//
+// Parameters:
+//   - key: The key to be added.
+//   - value: The value to be associated with the key.
+//
+// Returns:
+//   - true if the value was stored, false if a live entry already exists for the key.
+//
+// Details:
+//   - An expired entry for the key counts as absent and is replaced.
+//   - The check and the insert happen under a single write lock, so concurrent callers
+//     can use Add for deduplication or as a lightweight lock.
+func (c *LRU) Add(key string, value interface{}) bool {
+	key = c.normalizeKey(key)
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 
 	if element, exists := c.cache[key]; exists {
-		// Update the value and move the element to the front (most recently used)
 		entry := element.Value.(*entries)
-		entry.value = value
-		entry.expiration = c.calculateExpiry()
-		c.list.MoveToFront(element)
-	} else {
-		// Add a new element to the cache
-		entry := &entries{
-			key:        key,
-			value:      value,
-			expiration: c.calculateExpiry(),
-		}
-		element := c.list.PushFront(entry)
-		c.cache[key] = element
-
-		// If the cache is full, remove the least recently used item
-		if len(c.cache) > c.capacity {
-			oldest := c.list.Back()
-			if oldest != nil {
-				c.evict(oldest)
-			}
+		if !expired(entry, time.Now()) {
+			return false
 		}
+		c.expire(element)
 	}
+	c.set(key, value)
+	c.enforceQuotas(key)
+	c.enforceCapacity(c.cache[key])
+	return true
 }
 
 // Update updates the value associated with a key in the cache.
@@ -173,17 +267,99 @@ func (c *LRU) Set(key string, value interface{}) {
 //   - key: The key to update.
 //   - value: The new value to associate with the key.
 func (c *LRU) Update(key string, value interface{}) {
+	key = c.normalizeKey(key)
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 
+	stored, err := c.prepare(key, value)
+	if err != nil {
+		return
+	}
 	if element, exists := c.cache[key]; exists {
 		entry := element.Value.(*entries)
-		entry.value = value
-		entry.expiration = c.calculateExpiry()
-		c.list.MoveToFront(element)
+		stored = c.intern(stored)
+		c.replace(entry, stored)
+		c.unintern(entry.value)
+		entry.value = stored
+		entry.expiration = c.expiryOf(entry)
+		entry.accessTime = time.Now()
+		entry.version = c.nextVersion()
+		entry.delta = 0 // Only a Loader write records how long the value took; setLoaded sets it after
+		c.promote(element)
+		c.reindex(entry, value)
+		c.emit(EventSet, key, value)
 	}
 }
 
+// CompareAndSwap replaces the value of a key only if its current value equals old.
+//
+// Parameters:
+//   - key: The key to update.
+//   - old: The expected current value.
+//   - new: The value to store when the current value matches.
+//
+// Returns:
+//   - true if the swap happened, false if the key is absent, expired, or holds another value.
+//
+// Details:
+//   - Values are compared with ==; values of non-comparable types (maps, slices, funcs) never match.
+//   - On success the entry becomes the most recently used and its expiration is reset.
+func (c *LRU) CompareAndSwap(key string, old, new interface{}) bool {
+	key = c.normalizeKey(key)
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	element, exists := c.cache[key]
+	if !exists {
+		return false
+	}
+	entry := element.Value.(*entries)
+	if expired(entry, time.Now()) {
+		return false
+	}
+	if !equal(decompress(entry.value), old) {
+		return false
+	}
+	c.set(key, new)
+	return true
+}
+
+// UpdateFunc atomically applies a read-modify-write function to a key.
+//
+// Parameters:
+//   - key: The key to update.
+//   - fn: A function receiving the current value and whether the key exists (and has not expired).
+//     It returns the new value and whether that value should be stored.
+//
+// Returns:
+//   - true if fn asked for the value to be stored, false if the cache was left unchanged.
+//
+// Details:
+//   - fn runs under the cache's write lock, so it must be fast and must not call back into the cache.
+//   - Storing behaves like Set: it inserts absent keys and may evict to respect the capacity.
+func (c *LRU) UpdateFunc(key string, fn func(old interface{}, exists bool) (interface{}, bool)) bool {
+	key = c.normalizeKey(key)
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	var current interface{}
+	exists := false
+	if element, ok := c.cache[key]; ok {
+		entry := element.Value.(*entries)
+		if !expired(entry, time.Now()) {
+			current, exists = c.view(entry.value), true
+		}
+	}
+	value, store := fn(current, exists)
+	if !store {
+		return false
+	}
+	c.set(key, value)
+	c.enforceQuotas(key)
+	c.enforceCapacity(c.cache[key])
+	return true
+}
+
 // Remove deletes a specific key-value pair from the cache.
 //
 // Parameters:
@@ -192,6 +368,7 @@ func (c *LRU) Update(key string, value interface{}) {
 // Details:
 //   - If the key does not exist, the method does nothing.
 func (c *LRU) Remove(key string) {
+	key = c.normalizeKey(key)
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 	if element, exists := c.cache[key]; exists {
@@ -199,15 +376,115 @@ func (c *LRU) Remove(key string) {
 	}
 }
 
+// GetAndRemove atomically retrieves and deletes a key.
+//
+// Parameters:
+//   - key: The key to be retrieved and removed.
+//
+// Returns:
+//   - The value associated with the key, or nil if the key is not found or has expired.
+//   - A boolean indicating whether a live value was returned.
+//
+// Details:
+//   - Like Remove, the eviction callback is invoked for the removed entry.
+func (c *LRU) GetAndRemove(key string) (value interface{}, ok bool) {
+	key = c.normalizeKey(key)
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	element, exists := c.cache[key]
+	if !exists {
+		return nil, false
+	}
+	entry := element.Value.(*entries)
+	stale := expired(entry, time.Now())
+	value = decompress(entry.value)
+	c.evict(element)
+	if stale {
+		return nil, false
+	}
+	return value, true
+}
+
+// PopOldest atomically removes and returns the least recently used entry.
+//
+// Returns:
+//   - The key and value of the removed entry.
+//   - A boolean indicating whether an entry was removed.
+//
+// Details:
+//   - Expired entries met along the way are discarded and never returned.
+func (c *LRU) PopOldest() (key string, value interface{}, ok bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.pop(c.list.Back, func(e *list.Element) *list.Element { return e.Prev() })
+}
+
+// PopNewest atomically removes and returns the most recently used entry.
+//
+// Returns:
+//   - The key and value of the removed entry.
+//   - A boolean indicating whether an entry was removed.
+//
+// Details:
+//   - Expired entries met along the way are discarded and never returned.
+func (c *LRU) PopNewest() (key string, value interface{}, ok bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.pop(c.list.Front, func(e *list.Element) *list.Element { return e.Next() })
+}
+
+// RemoveWhere removes every entry matching a predicate.
+//
+// Parameters:
+//   - predicate: A function returning true for entries that should be removed.
+//
+// Returns:
+//   - The number of entries removed.
+//
+// Details:
+//   - Runs under a single write lock, so the predicate must not call back into the cache.
+//   - The eviction callback is invoked for every removed entry.
+func (c *LRU) RemoveWhere(predicate func(key string, value interface{}) bool) int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	removed := 0
+	for element := c.list.Back(); element != nil; {
+		prev := element.Prev()
+		entry := element.Value.(*entries)
+		if predicate(entry.key, decompress(entry.value)) {
+			c.evict(element)
+			removed++
+		}
+		element = prev
+	}
+	return removed
+}
+
 // Clear removes all key-value pairs from the cache.
 //
 // Details:
 //   - Resets the internal data structures to their initial state.
+//   - The eviction callback is not invoked, but per-entry callbacks registered with SetWithCallback are,
+//     so the resources they release are not leaked.
 func (c *LRU) Clear() {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
-	c.cache = make(map[string]*list.Element)
+	for element := c.list.Front(); element != nil; element = element.Next() {
+		c.replace(element.Value.(*entries), nil)
+	}
+	c.cache = make(map[string]*list.Element, mapHint(c.capacity))
 	c.list.Init()
+	c.tags = nil
+	c.index = nil
+	c.hashes = nil
+	c.interned = nil
+	c.dedupSaved = 0
+	c.prefixes = nil
+	c.sweepCursor = nil
+	c.orderQuotas()
+	c.emit(EventClear, "", nil)
 }
 
 // Len returns the current number of items in the cache.
@@ -230,18 +507,20 @@ func (c *LRU) IsEmpty() bool {
 
 // IsExpired checks if a specific key has expired without updating its access time.
 func (c *LRU) IsExpired(key string) bool {
+	key = c.normalizeKey(key)
 	c.mutex.RLock()
 	defer c.mutex.RUnlock()
 
 	if element, exists := c.cache[key]; exists {
 		entry := element.Value.(*entries)
-		return c.expiration > 0 && time.Now().After(entry.expiration)
+		return expired(entry, time.Now())
 	}
 	return false
 }
 
 // Contains checks if a key exists in the cache without updating its access time.
 func (c *LRU) Contains(key string) bool {
+	key = c.normalizeKey(key)
 	c.mutex.RLock()
 	defer c.mutex.RUnlock()
 	_, exists := c.cache[key]
@@ -251,17 +530,71 @@ func (c *LRU) Contains(key string) bool {
 // SetCapacity updates the capacity of the cache.
 // Allows you to dynamically update the capacity of the cache.
 // If the new capacity is less than the current number of items, it removes the excess items from the cache.
-func (c *LRU) SetCapacity(capacity int) {
+// A capacity of zero or less makes the cache unbounded.
+// The key map is rebuilt for the new capacity, releasing the memory held for evicted keys.
+//
+// Returns:
+//   - The number of entries evicted to fit the new capacity. Use Resize to get the entries themselves.
+//
+// Details:
+//   - Evicted entries go through the eviction callback and are reported as EventResize to the event listener.
+func (c *LRU) SetCapacity(capacity int) int {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
+	evicted, _ := c.resize(capacity, false)
+	return evicted
+}
+
+// Resize updates the capacity of the cache like SetCapacity, returning what it evicted.
+//
+// Parameters:
+//   - capacity: The new capacity. Zero or less makes the cache unbounded.
+//
+// Returns:
+//   - The evicted entries, in eviction order, so that shrinking can be audited. Empty if nothing was evicted.
+func (c *LRU) Resize(capacity int) []Entry {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	_, evicted := c.resize(capacity, true)
+	return evicted
+}
+
+// resize sets the capacity and evicts the entries that no longer fit.
+//
+// Parameters:
+//   - capacity: The new capacity.
+//   - collect: Whether the evicted entries are copied and returned.
+//
+// Returns:
+//   - The number of entries evicted.
+//   - The evicted entries when collect is set.
+//
+// Details:
+//   - Must be called with the write lock held.
+func (c *LRU) resize(capacity int, collect bool) (int, []Entry) {
+	changed := capacity != c.capacity
 	c.capacity = capacity
+	var removed []Entry
+	report := &removed
+	if !collect {
+		report = nil
+	}
 	// If the new capacity is less than the current number of items, remove the excess items
-	for len(c.cache) > c.capacity {
-		oldest := c.list.Back()
-		if oldest != nil {
-			c.evict(oldest)
-		}
+	evicted := c.evictOverflow(c.capacity, EventResize, report, nil)
+	if changed {
+		c.resizeMap()
 	}
+	return evicted, removed
+}
+
+// Capacity returns the maximum number of items the cache can hold.
+//
+// Returns:
+//   - The capacity, or zero or less if the cache is unbounded.
+func (c *LRU) Capacity() int {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.capacity
 }
 
 // SetCallback sets the eviction callback function.
@@ -294,36 +627,84 @@ func (c *LRU) SetCallback(callback OnCallback) {
 // Details:
 //   - This affects only new entries or updated entries after the call to SetExpiry.
 //   - Existing entries retain their current expiration times until updated.
+//   - Enabling expiry starts the background cleanup (unless the cache is lazy or closed),
+//     disabling it (expiry <= 0) stops the cleanup, and changing it restarts the cleanup
+//     when its interval is derived from the expiration.
 func (c *LRU) SetExpiry(expiry time.Duration) {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 	c.expiration = expiry
+	if expiry <= 0 {
+		c.stopJanitor()
+		return
+	}
+	c.startJanitor()
+}
+
+// expiry returns the default expiration duration for cache entries.
+//
+// Details:
+//   - Uses read locking to safely access the expiration setting.
+func (c *LRU) expiry() time.Duration {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.expiration
 }
 
 // GetStates returns a snapshot of the current cache state.
 //
+// Parameters:
+//   - order: An optional ordering of the result; only the first one is used. Without it, the
+//     states are returned in no particular order.
+//
 // Returns:
-//   - A slice of `state` objects representing all the items in the cache.
-//   - Each `state` includes the key, value, access time, and expiration time.
+//   - A slice of `State` objects representing all the items in the cache.
+//   - Each `State` includes the key, value, access time, and expiration time.
 //
 // Details:
 //   - Uses read locking to ensure safe concurrent access.
 //   - Iterates through all cache entries, capturing their metadata.
-//   - Creates a new `state` object for each entry using a builder-like pattern.
-func (c *LRU) GetStates() []state {
+//   - Creates a new `State` object for each entry using a builder-like pattern.
+//   - OrderLeastRecent lists the entries that LRU eviction removes next first, which suits
+//     "about to be evicted" views.
+func (c *LRU) GetStates(order ...StateOrder) []State {
 	c.mutex.RLock()
 	defer c.mutex.RUnlock()
 
-	snapshot := make([]state, 0, len(c.cache))
-	now := time.Now()
-	for _, element := range c.cache {
-		entry := element.Value.(*entries)
-		l := NewState().
-			WithKey(entry.key).
-			WithValue(entry.value).
-			WithAccessTime(now).
-			WithExpiration(entry.expiration)
-		snapshot = append(snapshot, *l)
+	by := OrderNone
+	if len(order) > 0 {
+		by = order[0]
+	}
+	snapshot := make([]State, 0, len(c.cache))
+	switch by {
+	case OrderNone:
+		for _, element := range c.cache {
+			snapshot = append(snapshot, *c.stateOf(element.Value.(*entries)))
+		}
+		return snapshot
+	case OrderLeastRecent:
+		for element := c.list.Back(); element != nil; element = element.Prev() {
+			snapshot = append(snapshot, *c.stateOf(element.Value.(*entries)))
+		}
+		return snapshot
+	}
+	// The remaining orders start from recency, so ties are broken by most recent use
+	for element := c.list.Front(); element != nil; element = element.Next() {
+		snapshot = append(snapshot, *c.stateOf(element.Value.(*entries)))
+	}
+	switch by {
+	case OrderExpiration:
+		sort.SliceStable(snapshot, func(i, j int) bool {
+			a, b := snapshot[i].expiration, snapshot[j].expiration
+			if a.IsZero() || b.IsZero() {
+				return !a.IsZero() && b.IsZero()
+			}
+			return a.Before(b)
+		})
+	case OrderAccessCount:
+		sort.SliceStable(snapshot, func(i, j int) bool {
+			return snapshot[i].accessCount > snapshot[j].accessCount
+		})
 	}
 	return snapshot
 }
@@ -331,30 +712,80 @@ func (c *LRU) GetStates() []state {
 // GetState returns the metadata of the least recently used (LRU) item without removing it.
 //
 // Returns:
-//   - A pointer to a `state` object representing the LRU item, or nil if the cache is empty.
+//   - A pointer to a `State` object representing the LRU item, or nil if the cache is empty.
 //   - A boolean indicating whether a valid state was retrieved.
 //
 // Details:
 //   - Uses read locking to safely access the cache state.
 //   - Retrieves the least recently used item from the tail of the doubly-linked list.
-//   - Constructs a `state` object to represent the item's metadata.
-func (c *LRU) GetState() (m *state, ok bool) {
+//   - Constructs a `State` object to represent the item's metadata.
+func (c *LRU) GetState() (m *State, ok bool) {
 	c.mutex.RLock()
 	defer c.mutex.RUnlock()
 
 	oldest := c.list.Back()
 	if oldest != nil {
 		entry := oldest.Value.(*entries)
-		l := NewState().
-			WithKey(entry.key).
-			WithValue(entry.value).
-			WithExpiration(entry.expiration).
-			WithAccessTime(time.Now())
-		return l, true
+		return c.stateOf(entry), true
+	}
+	return nil, false
+}
+
+// GetStateByKey returns the metadata of an arbitrary key without updating its access time.
+//
+// Parameters:
+//   - key: The key to inspect.
+//
+// Returns:
+//   - A pointer to a `State` object with the value, expiration, last access time, and access count
+//     of the entry, or nil if the key does not exist.
+//   - A boolean indicating whether the key exists.
+//
+// Details:
+//   - Uses read locking and does not modify the order of items, so it is safe for debugging and admin endpoints.
+//   - The remaining time-to-live is available through the State's Remaining method.
+func (c *LRU) GetStateByKey(key string) (m *State, ok bool) {
+	key = c.normalizeKey(key)
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	if element, exists := c.cache[key]; exists {
+		return c.stateOf(element.Value.(*entries)), true
 	}
 	return nil, false
 }
 
+// TopN returns the n most frequently read entries.
+//
+// Parameters:
+//   - n: The maximum number of entries to return.
+//
+// Returns:
+//   - A slice of `State` objects sorted by access count, hottest first.
+//     Ties are broken by recency, most recently used first.
+//
+// Details:
+//   - Uses read locking and does not modify the order of items in the cache.
+func (c *LRU) TopN(n int) []State {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	if n <= 0 {
+		return []State{}
+	}
+	snapshot := make([]State, 0, len(c.cache))
+	for element := c.list.Front(); element != nil; element = element.Next() {
+		snapshot = append(snapshot, *c.stateOf(element.Value.(*entries)))
+	}
+	sort.SliceStable(snapshot, func(i, j int) bool {
+		return snapshot[i].accessCount > snapshot[j].accessCount
+	})
+	if len(snapshot) > n {
+		snapshot = snapshot[:n]
+	}
+	return snapshot
+}
+
 // IsMostRecentlyUsed checks if a specific key is the most recently used item in the cache.
 //
 // Parameters:
@@ -368,6 +799,7 @@ func (c *LRU) GetState() (m *state, ok bool) {
 //   - Uses read locking to safely access the cache state.
 //   - Compares the provided key with the key of the item at the front of the list (MRU).
 func (c *LRU) IsMostRecentlyUsed(key string) bool {
+	key = c.normalizeKey(key)
 	c.mutex.RLock()
 	defer c.mutex.RUnlock()
 
@@ -381,26 +813,21 @@ func (c *LRU) IsMostRecentlyUsed(key string) bool {
 // GetMostRecentlyUsed returns the most recently used (MRU) key-value pair without removing it.
 //
 // Returns:
-//   - A pointer to a `state` object representing the MRU item, or nil if the cache is empty.
+//   - A pointer to a `State` object representing the MRU item, or nil if the cache is empty.
 //   - A boolean indicating whether a valid state was retrieved.
 //
 // Details:
 //   - Uses read locking to safely access the cache state.
 //   - Retrieves the most recently used item from the head of the doubly-linked list.
-//   - Constructs a `state` object to represent the item's metadata.
-func (c *LRU) GetMostRecentlyUsed() (m *state, ok bool) {
+//   - Constructs a `State` object to represent the item's metadata.
+func (c *LRU) GetMostRecentlyUsed() (m *State, ok bool) {
 	c.mutex.RLock()
 	defer c.mutex.RUnlock()
 
 	newest := c.list.Front()
 	if newest != nil {
 		entry := newest.Value.(*entries)
-		l := NewState().
-			WithKey(entry.key).
-			WithValue(entry.value).
-			WithExpiration(entry.expiration).
-			WithAccessTime(time.Now())
-		return l, true
+		return c.stateOf(entry), true
 	}
 	return nil, false
 }
@@ -414,16 +841,47 @@ func (c *LRU) GetMostRecentlyUsed() (m *state, ok bool) {
 // Details:
 //   - Uses write locking to ensure safe updates.
 //   - If the key exists, updates its expiration time and moves it to the front of the list.
+//   - Entries without an expiration never expire, so they are only moved to the front.
+//   - The new expiration is bounded by WithMinTTL and WithMaxTTL.
 //   - Does nothing if the key does not exist in the cache.
 func (c *LRU) ExpandExpiry(key string, expiry time.Duration) {
+	key = c.normalizeKey(key)
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 
 	if element, exists := c.cache[key]; exists {
 		entry := element.Value.(*entries)
-		entry.expiration = entry.expiration.Add(expiry)
-		c.list.MoveToFront(element)
+		if !entry.expiration.IsZero() {
+			entry.expiration = c.clampExpiry(entry.expiration.Add(expiry))
+		}
+		c.promote(element)
+	}
+}
+
+// Touch marks a key as the most recently used and resets its expiration without reading its value.
+//
+// Parameters:
+//   - key: The key to refresh.
+//
+// Returns:
+//   - true if the key exists and was refreshed, false if it is absent or has expired.
+//
+// Details:
+//   - The new expiration is now plus the cache's default expiration, as if the value had just been Set.
+//     Entries written with SetWithDeadline keep their deadline, and persistent ones keep never expiring.
+//   - Useful for keep-alive patterns where copying a large value on every Get is undesirable.
+func (c *LRU) Touch(key string) bool {
+	key = c.normalizeKey(key)
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry, exists := c.liveEntry(key)
+	if !exists {
+		return false
 	}
+	entry.expiration = c.expiryOf(entry)
+	c.touchEntry(entry)
+	return true
 }
 
 // PersistExpiry returns the remaining time until expiration for a specific key.
@@ -438,15 +896,15 @@ func (c *LRU) ExpandExpiry(key string, expiry time.Duration) {
 // Details:
 //   - Uses read locking to safely access the cache state.
 //   - If the key exists, calculates the time remaining until expiration.
-//   - Returns 0 and false if the key does not exist.
+//   - Returns 0 and false if the key does not exist or never expires.
 func (c *LRU) PersistExpiry(key string) (remain time.Duration, ok bool) {
+	key = c.normalizeKey(key)
 	c.mutex.RLock()
 	defer c.mutex.RUnlock()
 
 	if element, exists := c.cache[key]; exists {
 		entry := element.Value.(*entries)
-		if c.expiration > 0 {
-			// remain = entry.expiration.Sub(time.Now())
+		if !entry.expiration.IsZero() {
 			remain = time.Until(entry.expiration)
 			return remain, true
 		}
@@ -454,77 +912,444 @@ func (c *LRU) PersistExpiry(key string) (remain time.Duration, ok bool) {
 	return 0, false
 }
 
+// ExpiringWithin returns the entries that will expire within the given window.
+//
+// Parameters:
+//   - window: The look-ahead duration measured from now.
+//
+// Returns:
+//   - A slice of `State` objects sorted by expiration time, soonest first.
+//
+// Details:
+//   - Entries without an expiration and entries that have already expired are excluded.
+//   - Does not modify the order of items in the cache.
+func (c *LRU) ExpiringWithin(window time.Duration) []State {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	now := time.Now()
+	deadline := now.Add(window)
+	snapshot := make([]State, 0)
+	for _, element := range c.cache {
+		entry := element.Value.(*entries)
+		if entry.expiration.IsZero() || expired(entry, now) || entry.expiration.After(deadline) {
+			continue
+		}
+		snapshot = append(snapshot, *c.stateOf(entry))
+	}
+	sort.Slice(snapshot, func(i, j int) bool {
+		return snapshot[i].expiration.Before(snapshot[j].expiration)
+	})
+	return snapshot
+}
+
+// WithCleanupInterval sets how often the background cleanup removes expired entries.
+//
+// Parameters:
+//   - interval: The time between two cleanup runs. Zero or less restores the default of half the expiration.
+//
+// Returns:
+//   - The LRU cache, for chaining.
+//
+// Details:
+//   - If the background cleanup is running it is restarted with the new interval.
+//
+// Example Usage:
+//
+//	cache := cachify.NewLRUExpires(1000, time.Hour).WithCleanupInterval(time.Minute)
+func (c *LRU) WithCleanupInterval(interval time.Duration) *LRU {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.cleanupInterval = interval
+	if c.stopCleanup != nil {
+		c.startJanitor()
+	}
+	return c
+}
+
+// IsLazy checks if the cache relies on lazy expiration only, without a background cleanup.
+func (c *LRU) IsLazy() bool {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.lazy
+}
+
+// WithCleanupBudget bounds the work done by the background cleanup while holding the lock.
+//
+// Parameters:
+//   - maxEntries: The maximum number of entries examined per sweep. Zero or less means no limit.
+//   - maxDuration: The maximum time spent per sweep. Zero or less means no limit.
+//
+// Returns:
+//   - The LRU cache, for chaining.
+//
+// Details:
+//   - Each cleanup run is split into sweeps that release the write lock in between and resume
+//     where the previous sweep stopped, so large caches no longer freeze traffic during cleanup.
+//   - With both limits disabled, cleanup runs as a single full sweep.
+//   - PurgeExpired always performs a full sweep.
+func (c *LRU) WithCleanupBudget(maxEntries int, maxDuration time.Duration) *LRU {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.sweepEntries = maxEntries
+	c.sweepDuration = maxDuration
+	return c
+}
+
+// PurgeExpired removes every expired entry immediately.
+//
+// Returns:
+//   - The number of entries removed.
+//
+// Details:
+//   - Performs the same sweep as the background cleanup, on demand.
+//   - The eviction callback is invoked for every removed entry.
+func (c *LRU) PurgeExpired() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.purgeExpired()
+}
+
+// Close stops the background cleanup process and marks the cache as closed.
+//
+// Details:
+//   - After Close, the error-returning variants (GetE, SetE, UpdateE, RemoveE) return ErrClosed.
+//   - The other methods keep operating on the in-memory data.
+//   - When auto-snapshots are enabled, a final snapshot is written to disk.
+//   - Safe to call more than once.
+func (c *LRU) Close() {
+	c.mutex.Lock()
+	if c.closed {
+		c.mutex.Unlock()
+		return
+	}
+	c.closed = true
+	c.stopJanitor()
+	c.stopMemoryPressure()
+	path := c.stopAutoSnapshot()
+	c.mutex.Unlock()
+
+	if path != "" {
+		c.autoSnapshot(path)
+	}
+}
+
+// IsClosed checks if the cache has been closed.
+func (c *LRU) IsClosed() bool {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.closed
+}
+
 // DestroyCleanup stops the background cleanup process.
 //
 // Details:
 //   - Should be called when the cache is no longer needed to prevent goroutine leaks.
+//   - Safe to call on a cache without a running cleanup and safe to call more than once.
+//   - A later SetExpiry with a positive duration starts the cleanup again.
 func (c *LRU) DestroyCleanup() {
-	close(c.stopCleanup)
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.stopJanitor()
 }
 
-// evict removes a given element from the cache.
+// access records a lookup of an element and returns its value.
 //
 // Parameters:
-//   - element: The list element to be removed.
+//   - element: The element found for the key, or nil on a miss.
+//
+// Returns:
+//   - The value and true on a hit, or nil and false if the element is nil or has expired.
 //
 // Details:
-//   - Executes the eviction callback (if any) before removal.
-func (c *LRU) evict(element *list.Element) {
-	// Invoke the eviction callback before removing the item
-	if c.onEvict != nil {
+//   - Must be called with the write lock held.
+//   - Expired entries are evicted; hits become the most recently used and update the statistics.
+func (c *LRU) access(element *list.Element) (value interface{}, ok bool) {
+	if element == nil {
+		c.stats.miss()
+		return nil, false
+	}
+	entry := element.Value.(*entries)
+	now := time.Now()
+	// Check if the entry has expired
+	if expired(entry, now) {
+		// If the entry has expired, evict it from the cache
+		c.countNamespaces(entry.key, (*counters).miss)
+		c.expire(element)
+		c.stats.miss()
+		return nil, false
+	}
+	// Move the accessed element to the front of the list (most recently used)
+	c.promote(element)
+	entry.accessTime = now
+	entry.accessCount++
+	c.stats.hit()
+	c.countNamespaces(entry.key, (*counters).hit)
+	return c.view(entry.value), true
+}
+
+// set inserts or updates a key-value pair without enforcing the capacity.
+//
+// Parameters:
+//   - key: The key to be added or updated.
+//   - value: The value to be associated with the key.
+//
+// Returns:
+//   - The entry holding the key, or nil if the value was rejected (see write).
+//
+// Details:
+//   - Must be called with the write lock held.
+//   - Moves the entry to the front of the list and resets its expiration.
+func (c *LRU) set(key string, value interface{}) *entries {
+	entry, _ := c.write(key, value)
+	return entry
+}
+
+// write inserts or updates a key-value pair like set, reporting why a value was rejected.
+//
+// Returns:
+//   - The entry holding the key, or nil if the value was rejected.
+//   - A *ValueSizeError if the value exceeds the maximum value size, or the codec error if
+//     serialization is enabled and the value cannot be encoded. Any existing entry for the key is removed.
+//
+// Details:
+//   - Must be called with the write lock held.
+func (c *LRU) write(key string, value interface{}) (*entries, error) {
+	stored, err := c.prepare(key, value)
+	if err != nil {
+		return nil, err
+	}
+	stored = c.intern(stored)
+	c.emit(EventSet, key, value)
+	if element, exists := c.cache[key]; exists {
+		// Update the value and move the element to the front (most recently used)
 		entry := element.Value.(*entries)
-		c.onEvict(entry.key, entry.value)
+		c.replace(entry, stored)
+		c.unintern(entry.value)
+		entry.value = stored
+		entry.deadline = false // A plain write replaces a deadline with the cache-wide expiry
+		entry.expiration = c.expiryOf(entry)
+		entry.accessTime = time.Now()
+		entry.version = c.nextVersion()
+		entry.delta = 0 // Only a Loader write records how long the value took; setLoaded sets it after
+		c.promote(element)
+		c.reindex(entry, value)
+		return entry, nil
 	}
-	delete(c.cache, element.Value.(*entries).key)
-	c.list.Remove(element)
+	// Add a new element to the cache
+	entry := c.newEntry()
+	entry.key = key
+	entry.value = stored
+	entry.expiration = c.calculateExpiry()
+	entry.accessTime = time.Now()
+	entry.version = c.nextVersion()
+	c.cache[key] = c.list.PushFront(entry)
+	c.linkQuotas(c.cache[key])
+	c.admitGhost(key)
+	if c.prefixes != nil {
+		c.prefixes.insert(key)
+	}
+	c.reindex(entry, value)
+	return entry, nil
 }
 
-// cleanupExpired removes all expired entries from the cache.
+// enforceCapacity evicts items according to the eviction policy until the cache fits its capacity.
+//
+// Parameters:
+//   - written: The element just written, which EvictMRU spares, or nil if no entry was written.
 //
 // Details:
-//   - Iterates through all items and evicts those that have exceeded their expiration time.
-func (c *LRU) cleanupExpired() {
-	c.mutex.Lock()
-	defer c.mutex.Unlock()
+//   - Must be called with the write lock held.
+//   - Pinned items are skipped, so the cache may stay above capacity if too many items are pinned.
+//   - With a low watermark, an overflowing cache is evicted down to the watermark in one batch.
+func (c *LRU) enforceCapacity(written *list.Element) {
+	if c.capacity > 0 && len(c.cache) > c.capacity {
+		c.evictOverflow(c.watermark(), EventEvict, nil, written)
+	}
+}
 
+// evictOverflow evicts entries according to the eviction policy until the cache holds at most target entries.
+//
+// Parameters:
+//   - target: The number of entries to evict down to; zero or less evicts nothing.
+//   - op: The operation reported to the event listener for each eviction.
+//   - report: If not nil, receives a copy of every evicted entry, in eviction order.
+//   - written: The element just written, which EvictMRU spares, or nil.
+//
+// Returns:
+//   - The number of entries evicted.
+//
+// Details:
+//   - Must be called with the write lock held.
+func (c *LRU) evictOverflow(target int, op EventOp, report *[]Entry, written *list.Element) int {
+	if target <= 0 {
+		return 0
+	}
+	evicted := 0
+	for len(c.cache) > target {
+		victim := c.victim(written)
+		if victim == nil {
+			break
+		}
+		entry := victim.Value.(*entries)
+		if report != nil {
+			*report = append(*report, c.entryOf(entry))
+		}
+		c.remember(entry.key)
+		c.stats.evict()
+		c.drop(victim, op)
+		evicted++
+	}
+	return evicted
+}
+
+// victim returns the element that capacity-based eviction should remove next.
+//
+// Parameters:
+//   - written: The element just written, or nil.
+//
+// Returns:
+//   - The least recently used element that is not pinned, or nil if every element is pinned.
+//     Under EvictMRU, the most recently used one other than written instead.
+//
+// Details:
+//   - Must be called with the read or write lock held.
+func (c *LRU) victim(written *list.Element) *list.Element {
+	if c.policy == EvictMRU {
+		for element := c.list.Front(); element != nil; element = element.Next() {
+			if element != written && !element.Value.(*entries).pinned {
+				return element
+			}
+		}
+		return nil
+	}
+	for element := c.list.Back(); element != nil; element = element.Prev() {
+		if !element.Value.(*entries).pinned {
+			return element
+		}
+	}
+	return nil
+}
+
+// pop removes and returns the first live entry found walking the list from one end.
+//
+// Parameters:
+//   - first: Returns the element to start from.
+//   - next: Returns the element following a given one in the walking direction.
+//
+// Details:
+//   - Must be called with the write lock held.
+func (c *LRU) pop(first func() *list.Element, next func(*list.Element) *list.Element) (key string, value interface{}, ok bool) {
 	now := time.Now()
-	for _, element := range c.cache {
+	for element := first(); element != nil; {
+		following := next(element)
 		entry := element.Value.(*entries)
-		if entry.expiration.After(now) {
-			// Entry has expired, evict it from the cache
-			c.evict(element)
+		key, value, stale := entry.key, decompress(entry.value), expired(entry, now)
+		c.evict(element)
+		if !stale {
+			return key, value, true
 		}
+		element = following
 	}
+	return "", nil, false
 }
 
-// startCleanup starts a background goroutine to periodically remove expired entries.
+// resizeMap rebuilds the key map sized for the current capacity.
 //
 // Details:
-//   - Runs a cleanup operation at regular intervals to evict expired items.
-//   - Stops when the `stopCleanup` channel is closed.
-func (c *LRU) startCleanup() {
-	ticker := time.NewTicker(c.expiration / 2) // Run cleanup at half the expiration interval
-	defer ticker.Stop()
-	for {
-		select {
-		case <-ticker.C:
-			c.cleanupExpired()
-		case <-c.stopCleanup:
-			return
-		}
+//   - Must be called with the write lock held.
+//   - Go maps never shrink, so after lowering the capacity this releases the slots of evicted keys;
+//     after raising it, it reserves room for the new capacity.
+func (c *LRU) resizeMap() {
+	resized := make(map[string]*list.Element, max(mapHint(c.capacity), len(c.cache)))
+	for key, element := range c.cache {
+		resized[key] = element
 	}
+	c.cache = resized
 }
 
-// calculateExpiry calculates the expiration time for a new cache entry.
+// mapHint returns the number of map slots to reserve for a capacity.
+func mapHint(capacity int) int {
+	if capacity <= 0 {
+		return 0
+	}
+	return min(capacity, maxPreallocate)
+}
+
+// equal reports whether two values are equal without panicking on non-comparable types.
+func equal(a, b interface{}) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	ta, tb := reflect.TypeOf(a), reflect.TypeOf(b)
+	if ta != tb || !ta.Comparable() {
+		return false
+	}
+	return a == b
+}
+
+// stateOf builds a `State` object describing an entry.
 //
-// Returns:
-//   - A time.Time value representing the expiration time.
+// Details:
+//   - Must be called with the read or write lock held.
+func (c *LRU) stateOf(entry *entries) *State {
+	return NewState().
+		WithKey(entry.key).
+		WithValue(c.view(entry.value)).
+		WithExpiration(entry.expiration).
+		WithAccessTime(entry.accessTime).
+		WithAccessCount(entry.accessCount).
+		WithMeta(copyMeta(entry.meta)).
+		WithVersion(entry.version)
+}
+
+// evict removes a given element from the cache.
+//
+// Parameters:
+//   - element: The list element to be removed.
 //
 // Details:
-//   - If no expiration is set, returns the zero value for time.Time.
-func (c *LRU) calculateExpiry() time.Time {
-	if c.expiration > 0 {
-		return time.Now().Add(c.expiration)
+//   - Executes the eviction callback (if any) before removal.
+//   - Reports EventRemove to the event listener; use drop to report another operation.
+//   - Recycles the entry, so callers must read anything they need from it beforehand.
+func (c *LRU) evict(element *list.Element) {
+	c.drop(element, EventRemove)
+}
+
+// drop removes an element like evict, reporting the given operation to the event listener.
+//
+// Details:
+//   - Must be called with the write lock held.
+//   - Recycles the entry, so callers must read anything they need from it beforehand.
+func (c *LRU) drop(element *list.Element, op EventOp) {
+	entry := element.Value.(*entries)
+	if c.onEvent != nil {
+		c.emit(op, entry.key, decompress(entry.value))
 	}
-	return time.Time{}
+	// Invoke the eviction callback before removing the item
+	if c.onEvict != nil {
+		value := decompress(entry.value)
+		invoke(&c.stats, c.onPanic, "evict", entry.key, func() { c.onEvict(entry.key, value) })
+	}
+	c.replace(entry, nil)
+	c.unintern(entry.value)
+	switch op {
+	case EventEvict, EventResize:
+		c.countNamespaces(entry.key, (*counters).evict)
+	case EventExpire:
+		c.countNamespaces(entry.key, (*counters).expire)
+		c.notifyExpire(entry)
+	}
+	c.untag(entry)
+	c.unindex(entry)
+	if c.prefixes != nil {
+		c.prefixes.remove(entry.key)
+	}
+	c.unlinkQuotas(element)
+	delete(c.cache, entry.key)
+	c.list.Remove(element)
+	// Buffered reads and the sweep cursor may still hold the element: leave them nothing to read
+	element.Value = nil
+	releaseEntry(entry)
 }
@@ -0,0 +1,223 @@
+package cachify
+
+import (
+	"container/heap"
+	"container/list"
+)
+
+// NewLRUK creates a new LRU-K cache.
+//
+// Parameters:
+//   - capacity: The maximum number of items the cache can hold. Zero or less means unbounded.
+//   - k: The number of most recent accesses tracked per entry; values less than 1 are treated as 2 (LRU-2).
+//
+// Returns:
+//   - A pointer to an initialized LRUK cache.
+//
+// Details:
+//   - The access history of evicted keys is retained for up to `capacity` keys, so a key that
+//     comes back soon after eviction keeps its history.
+func NewLRUK(capacity, k int) *LRUK {
+	if k < 1 {
+		k = 2
+	}
+	return &LRUK{
+		capacity: capacity,
+		k:        k,
+		queue:    lrukQueue{k: k},
+		items:    make(map[string]*lrukEntry),
+		history:  make(map[string][]uint64),
+		retained: list.New(),
+	}
+}
+
+// Get retrieves the value associated with a given key and records the access.
+//
+// Parameters:
+//   - key: The key whose value is to be retrieved.
+//
+// Returns:
+//   - The value associated with the key, or nil if the key is not found.
+//   - A boolean indicating whether the key exists.
+func (c *LRUK) Get(key string) (value interface{}, ok bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	entry, exists := c.items[key]
+	if !exists {
+		c.stats.miss()
+		return nil, false
+	}
+	c.reference(entry)
+	c.stats.hit()
+	return entry.value, true
+}
+
+// Set inserts or updates a key-value pair in the cache and records the access.
+//
+// Parameters:
+//   - key: The key to be added or updated.
+//   - value: The value to be associated with the key.
+//
+// Details:
+//   - If the cache is full, the entry with the oldest K-th most recent access is evicted; entries with
+//     fewer than K accesses go first, least recently used among them.
+func (c *LRUK) Set(key string, value interface{}) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if entry, exists := c.items[key]; exists {
+		entry.value = value
+		c.reference(entry)
+		return
+	}
+	if c.capacity > 0 && len(c.items) >= c.capacity {
+		c.evict()
+	}
+	entry := &lrukEntry{key: key, value: value, refs: c.history[key]}
+	delete(c.history, key)
+	c.record(entry)
+	c.items[key] = entry
+	heap.Push(&c.queue, entry)
+}
+
+// Remove deletes a key from the cache.
+//
+// Parameters:
+//   - key: The key to be removed.
+func (c *LRUK) Remove(key string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if entry, exists := c.items[key]; exists {
+		heap.Remove(&c.queue, entry.index)
+		delete(c.items, key)
+	}
+}
+
+// Contains checks whether a key exists in the cache without recording an access.
+func (c *LRUK) Contains(key string) bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	_, exists := c.items[key]
+	return exists
+}
+
+// Len returns the number of items in the cache.
+func (c *LRUK) Len() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return len(c.items)
+}
+
+// Capacity returns the maximum number of items the cache can hold.
+func (c *LRUK) Capacity() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.capacity
+}
+
+// K returns the number of most recent accesses tracked per entry.
+func (c *LRUK) K() int {
+	return c.k
+}
+
+// Clear removes all items and retained history from the cache without invoking the eviction callback.
+func (c *LRUK) Clear() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.items = make(map[string]*lrukEntry)
+	c.queue.entries = nil
+	c.history = make(map[string][]uint64)
+	c.retained.Init()
+}
+
+// SetCallback sets the callback function invoked when an item is evicted.
+func (c *LRUK) SetCallback(callback OnCallback) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.onEvict = callback
+}
+
+// reference records an access to an entry and restores the heap order.
+// It must be called with the lock held.
+func (c *LRUK) reference(entry *lrukEntry) {
+	c.record(entry)
+	heap.Fix(&c.queue, entry.index)
+}
+
+// record prepends the current logical time to the access history of an entry.
+// It must be called with the lock held.
+func (c *LRUK) record(entry *lrukEntry) {
+	c.tick++
+	if len(entry.refs) < c.k {
+		entry.refs = append(entry.refs, 0)
+	}
+	copy(entry.refs[1:], entry.refs)
+	entry.refs[0] = c.tick
+}
+
+// evict removes the entry with the highest eviction priority and retains its history.
+// It must be called with the lock held.
+func (c *LRUK) evict() {
+	if len(c.queue.entries) == 0 {
+		return
+	}
+	entry := heap.Pop(&c.queue).(*lrukEntry)
+	delete(c.items, entry.key)
+	c.stats.evict()
+	c.history[entry.key] = entry.refs
+	c.retained.PushBack(entry.key)
+	for c.retained.Len() > c.capacity {
+		oldest := c.retained.Front()
+		key := c.retained.Remove(oldest).(string)
+		// A re-admitted key has already taken its history back
+		if _, cached := c.items[key]; !cached {
+			delete(c.history, key)
+		}
+	}
+	if c.onEvict != nil {
+		invoke(&c.stats, c.onPanic, "evict", entry.key, func() { c.onEvict(entry.key, entry.value) })
+	}
+}
+
+// Len returns the number of entries in the heap.
+func (q *lrukQueue) Len() int { return len(q.entries) }
+
+// Less orders entries by K-th most recent access, then by most recent access.
+func (q *lrukQueue) Less(i, j int) bool {
+	a, b := q.entries[i], q.entries[j]
+	if ka, kb := q.distance(a), q.distance(b); ka != kb {
+		return ka < kb
+	}
+	return a.refs[0] < b.refs[0]
+}
+
+// Swap swaps two entries and updates their positions.
+func (q *lrukQueue) Swap(i, j int) {
+	q.entries[i], q.entries[j] = q.entries[j], q.entries[i]
+	q.entries[i].index = i
+	q.entries[j].index = j
+}
+
+// Push appends an entry to the heap.
+func (q *lrukQueue) Push(x interface{}) {
+	entry := x.(*lrukEntry)
+	entry.index = len(q.entries)
+	q.entries = append(q.entries, entry)
+}
+
+// Pop removes the last entry of the heap.
+func (q *lrukQueue) Pop() interface{} {
+	n := len(q.entries)
+	entry := q.entries[n-1]
+	q.entries[n-1] = nil
+	q.entries = q.entries[:n-1]
+	return entry
+}
+
+// distance returns the K-th most recent access time of an entry, or zero if it has fewer than K accesses.
+func (q *lrukQueue) distance(entry *lrukEntry) uint64 {
+	if len(entry.refs) < q.k {
+		return 0
+	}
+	return entry.refs[q.k-1]
+}
@@ -0,0 +1,44 @@
+package cachify
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// MarshalJSON encodes the stats with their hit ratio, so they can be returned from admin endpoints as is.
+//
+// Returns:
+//   - A JSON object with the counters in snake_case, "hit_ratio", and "latency" when latency tracking is enabled.
+func (s Stats) MarshalJSON() ([]byte, error) {
+	// The alias drops the MarshalJSON method, avoiding an infinite recursion
+	type stats Stats
+	return json.Marshal(struct {
+		stats
+		HitRatio float64 `json:"hit_ratio"`
+	}{stats(s), s.HitRatio()})
+}
+
+// MarshalJSON encodes the state of an entry, so states can be returned from admin endpoints as is.
+//
+// Returns:
+//   - A JSON object with "key", "value", "access_time", "access_count", and "version", plus
+//     "expiration" and "remaining_ns" when the entry expires and "meta" when it has metadata.
+func (l State) MarshalJSON() ([]byte, error) {
+	var expiration *time.Time
+	var remaining *time.Duration
+	if !l.expiration.IsZero() {
+		expiration = &l.expiration
+		left := l.Remaining()
+		remaining = &left
+	}
+	return json.Marshal(struct {
+		Key         string            `json:"key"`
+		Value       interface{}       `json:"value"`
+		AccessTime  time.Time         `json:"access_time"`
+		AccessCount uint64            `json:"access_count"`
+		Expiration  *time.Time        `json:"expiration,omitempty"`
+		Remaining   *time.Duration    `json:"remaining_ns,omitempty"`
+		Meta        map[string]string `json:"meta,omitempty"`
+		Version     uint64            `json:"version"`
+	}{l.key, l.value, l.accessTime, l.accessCount, expiration, remaining, l.meta, l.version})
+}
@@ -0,0 +1,120 @@
+package memcacheadapter
+
+import (
+	"hash/crc32"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/bradfitz/gomemcache/memcache"
+)
+
+// defaultReplicas is the number of points each server owns on the hash ring.
+const defaultReplicas = 160
+
+// Ring is a memcache.ServerSelector that distributes keys with consistent hashing,
+// so adding or removing a server only remaps the keys owned by that server.
+//
+// Fields:
+//   - mutex: A read-write lock guarding the ring.
+//   - replicas: The number of virtual points per server.
+//   - points: The sorted hash points on the ring.
+//   - owners: The server owning each point.
+//   - addrs: The distinct server addresses.
+type Ring struct {
+	mutex    sync.RWMutex
+	replicas int
+	points   []uint32
+	owners   map[uint32]net.Addr
+	addrs    []net.Addr
+}
+
+var _ memcache.ServerSelector = (*Ring)(nil)
+
+// NewRing creates a consistent-hash ring over the given servers.
+//
+// Parameters:
+//   - servers: Server addresses ("host:port", or a path for Unix sockets).
+//
+// Returns:
+//   - A pointer to an initialized Ring.
+//   - An error if any server address cannot be resolved.
+func NewRing(servers ...string) (*Ring, error) {
+	r := &Ring{replicas: defaultReplicas}
+	if err := r.SetServers(servers...); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// SetServers replaces the set of servers on the ring.
+//
+// Details:
+//   - Safe for concurrent use; if any address fails to resolve, the ring is left unchanged.
+func (r *Ring) SetServers(servers ...string) error {
+	addrs := make([]net.Addr, 0, len(servers))
+	for _, server := range servers {
+		addr, err := resolve(server)
+		if err != nil {
+			return err
+		}
+		addrs = append(addrs, addr)
+	}
+	points := make([]uint32, 0, len(addrs)*r.replicas)
+	owners := make(map[uint32]net.Addr, len(addrs)*r.replicas)
+	for _, addr := range addrs {
+		for i := 0; i < r.replicas; i++ {
+			point := crc32.ChecksumIEEE([]byte(addr.String() + "-" + strconv.Itoa(i)))
+			if _, exists := owners[point]; exists {
+				continue
+			}
+			owners[point] = addr
+			points = append(points, point)
+		}
+	}
+	sort.Slice(points, func(i, j int) bool { return points[i] < points[j] })
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.addrs = addrs
+	r.points = points
+	r.owners = owners
+	return nil
+}
+
+// PickServer returns the server owning the given key.
+func (r *Ring) PickServer(key string) (net.Addr, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	if len(r.points) == 0 {
+		return nil, memcache.ErrNoServers
+	}
+	hash := crc32.ChecksumIEEE([]byte(key))
+	i := sort.Search(len(r.points), func(i int) bool { return r.points[i] >= hash })
+	if i == len(r.points) {
+		i = 0
+	}
+	return r.owners[r.points[i]], nil
+}
+
+// Each iterates over each server on the ring.
+func (r *Ring) Each(f func(net.Addr) error) error {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	for _, addr := range r.addrs {
+		if err := f(addr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolve converts a server string into a network address.
+func resolve(server string) (net.Addr, error) {
+	if strings.Contains(server, "/") {
+		return net.ResolveUnixAddr("unix", server)
+	}
+	return net.ResolveTCPAddr("tcp", server)
+}
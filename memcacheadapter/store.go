@@ -0,0 +1,146 @@
+// Package memcacheadapter provides a memcached-backed implementation of cachify.Store.
+package memcacheadapter
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bradfitz/gomemcache/memcache"
+	"github.com/pnguyen215/cachify"
+)
+
+// maxRelativeExpiration is the largest expiration memcached interprets as relative seconds;
+// larger values are treated as absolute Unix timestamps.
+const maxRelativeExpiration = 30 * 24 * time.Hour
+
+// Store is a cachify.Store backed by memcached.
+//
+// Fields:
+//   - client: The gomemcache client used for all commands.
+//   - prefix: A string prepended to every key written to memcached.
+//   - codec: The codec used to serialize values.
+type Store struct {
+	client *memcache.Client
+	prefix string
+	codec  cachify.Codec
+}
+
+var _ cachify.Store = (*Store)(nil)
+
+// NewStore creates a new memcached-backed store from an existing client.
+//
+// Parameters:
+//   - client: A gomemcache client.
+//
+// Returns:
+//   - A pointer to an initialized Store using JSON encoding and no key prefix.
+func NewStore(client *memcache.Client) *Store {
+	return &Store{
+		client: client,
+		codec:  cachify.JSONCodec{},
+	}
+}
+
+// NewRingStore creates a new memcached-backed store whose keys are spread across
+// the given servers with consistent hashing.
+//
+// Parameters:
+//   - servers: Server addresses ("host:port", or a path for Unix sockets).
+//
+// Returns:
+//   - A pointer to an initialized Store.
+//   - An error if any server address cannot be resolved.
+func NewRingStore(servers ...string) (*Store, error) {
+	ring, err := NewRing(servers...)
+	if err != nil {
+		return nil, err
+	}
+	return NewStore(memcache.NewFromSelector(ring)), nil
+}
+
+// WithPrefix sets a prefix prepended to every key.
+//
+// Returns:
+//   - The Store, for chaining.
+func (s *Store) WithPrefix(prefix string) *Store {
+	s.prefix = prefix
+	return s
+}
+
+// WithCodec sets the codec used to serialize values, e.g. cachify.GobCodec{} to preserve Go types.
+//
+// Returns:
+//   - The Store, for chaining.
+func (s *Store) WithCodec(codec cachify.Codec) *Store {
+	s.codec = codec
+	return s
+}
+
+// Get retrieves and decodes the value stored for a key.
+//
+// Details:
+//   - memcached has no per-request deadline support in gomemcache, so ctx is only checked before the call.
+func (s *Store) Get(ctx context.Context, key string) (value interface{}, ok bool, err error) {
+	if err := ctx.Err(); err != nil {
+		return nil, false, err
+	}
+	item, err := s.client.Get(s.prefix + key)
+	if errors.Is(err, memcache.ErrCacheMiss) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	if err := s.codec.Unmarshal(item.Value, &value); err != nil {
+		return nil, false, err
+	}
+	return value, true, nil
+}
+
+// Set encodes and stores a value for a key.
+//
+// Details:
+//   - The ttl is converted to memcached expiration semantics; zero means the item does not expire.
+func (s *Store) Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	data, err := s.codec.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return s.client.Set(&memcache.Item{
+		Key:        s.prefix + key,
+		Value:      data,
+		Expiration: expiration(ttl),
+	})
+}
+
+// Delete removes a key from memcached.
+func (s *Store) Delete(ctx context.Context, key string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	err := s.client.Delete(s.prefix + key)
+	if errors.Is(err, memcache.ErrCacheMiss) {
+		return nil
+	}
+	return err
+}
+
+// expiration converts a ttl into the memcached expiration field.
+func expiration(ttl time.Duration) int32 {
+	if ttl <= 0 {
+		return 0
+	}
+	if ttl > maxRelativeExpiration {
+		return int32(time.Now().Add(ttl).Unix())
+	}
+	seconds := int32(ttl / time.Second)
+	if ttl%time.Second != 0 {
+		// Round up so sub-second TTLs do not become "never expires"
+		seconds++
+	}
+	return seconds
+}
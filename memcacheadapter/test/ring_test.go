@@ -0,0 +1,32 @@
+package test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/pnguyen215/cachify/memcacheadapter"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test removing a server only remaps the keys it owned
+func TestRing_ConsistentRemap(t *testing.T) {
+	ring, err := memcacheadapter.NewRing("127.0.0.1:11211", "127.0.0.1:11212", "127.0.0.1:11213")
+	assert.NoError(t, err)
+
+	before := make(map[string]string)
+	for i := 0; i < 1000; i++ {
+		key := "key-" + strconv.Itoa(i)
+		addr, err := ring.PickServer(key)
+		assert.NoError(t, err)
+		before[key] = addr.String()
+	}
+
+	assert.NoError(t, ring.SetServers("127.0.0.1:11211", "127.0.0.1:11212"))
+	for key, owner := range before {
+		addr, err := ring.PickServer(key)
+		assert.NoError(t, err)
+		if owner != "127.0.0.1:11213" {
+			assert.Equal(t, owner, addr.String(), key)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package test
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bradfitz/gomemcache/memcache"
+	"github.com/pnguyen215/cachify"
+	"github.com/pnguyen215/cachify/memcacheadapter"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeMemcached is an in-process server speaking the subset of the memcached text protocol used by
+// the store, recording the expiration sent with every item.
+type fakeMemcached struct {
+	mutex       sync.Mutex
+	items       map[string][]byte
+	expirations map[string]int64
+}
+
+// newMemcached starts a fake memcached server and returns it with its address.
+func newMemcached(t *testing.T) (*fakeMemcached, string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+	server := &fakeMemcached{items: make(map[string][]byte), expirations: make(map[string]int64)}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go server.serve(conn)
+		}
+	}()
+	return server, listener.Addr().String()
+}
+
+// serve answers the get, set and delete commands of one connection.
+func (s *fakeMemcached) serve(conn net.Conn) {
+	defer conn.Close()
+	rw := bufio.NewReadWriter(bufio.NewReader(conn), bufio.NewWriter(conn))
+	for {
+		line, err := rw.ReadString('\n')
+		if err != nil {
+			return
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			return
+		}
+		s.mutex.Lock()
+		switch fields[0] {
+		case "get", "gets":
+			for _, key := range fields[1:] {
+				if data, ok := s.items[key]; ok {
+					fmt.Fprintf(rw, "VALUE %s 0 %d 1\r\n%s\r\n", key, len(data), data)
+				}
+			}
+			fmt.Fprint(rw, "END\r\n")
+		case "set":
+			exptime, _ := strconv.ParseInt(fields[3], 10, 64)
+			size, _ := strconv.Atoi(fields[4])
+			data := make([]byte, size+2)
+			if _, err := io.ReadFull(rw, data); err != nil {
+				s.mutex.Unlock()
+				return
+			}
+			s.items[fields[1]] = data[:size]
+			s.expirations[fields[1]] = exptime
+			fmt.Fprint(rw, "STORED\r\n")
+		case "delete":
+			if _, ok := s.items[fields[1]]; ok {
+				delete(s.items, fields[1])
+				fmt.Fprint(rw, "DELETED\r\n")
+			} else {
+				fmt.Fprint(rw, "NOT_FOUND\r\n")
+			}
+		default:
+			fmt.Fprint(rw, "ERROR\r\n")
+		}
+		s.mutex.Unlock()
+		if err := rw.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+// expiration returns the expiration last sent for a key.
+func (s *fakeMemcached) expiration(key string) int64 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.expirations[key]
+}
+
+// Test the memcached store converts TTLs to memcached expirations and prefixes keys
+func TestMemcacheStore(t *testing.T) {
+	server, addr := newMemcached(t)
+	store := memcacheadapter.NewStore(memcache.New(addr)).WithPrefix("app:")
+	ctx := context.Background()
+
+	assert.NoError(t, store.Set(ctx, "forever", "a", 0))
+	assert.NoError(t, store.Set(ctx, "short", "b", 1500*time.Millisecond))
+	assert.NoError(t, store.Set(ctx, "sub", "c", time.Millisecond))
+	assert.NoError(t, store.Set(ctx, "long", "d", 60*24*time.Hour))
+	assert.Equal(t, int64(0), server.expiration("app:forever"))
+	assert.Equal(t, int64(2), server.expiration("app:short"))
+	assert.Equal(t, int64(1), server.expiration("app:sub"))
+	assert.InDelta(t, time.Now().Add(60*24*time.Hour).Unix(), server.expiration("app:long"), 5)
+
+	value, ok, err := store.Get(ctx, "forever")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "a", value)
+
+	assert.NoError(t, store.Delete(ctx, "forever"))
+	_, ok, err = store.Get(ctx, "forever")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.NoError(t, store.Delete(ctx, "forever"))
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.ErrorIs(t, store.Set(cancelled, "x", 1, 0), context.Canceled)
+	_, _, err = store.Get(cancelled, "short")
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+// Test the memcached store encodes with the selected codec
+func TestMemcacheStore_Codec(t *testing.T) {
+	_, addr := newMemcached(t)
+	ctx := context.Background()
+
+	jsonStore := memcacheadapter.NewStore(memcache.New(addr))
+	assert.NoError(t, jsonStore.Set(ctx, "n", 42, 0))
+	value, _, err := jsonStore.Get(ctx, "n")
+	assert.NoError(t, err)
+	assert.Equal(t, float64(42), value)
+
+	gobStore := memcacheadapter.NewStore(memcache.New(addr)).WithCodec(cachify.GobCodec{})
+	assert.NoError(t, gobStore.Set(ctx, "n", 42, 0))
+	value, _, err = gobStore.Get(ctx, "n")
+	assert.NoError(t, err)
+	assert.Equal(t, 42, value)
+
+	// A value written with another codec fails to decode rather than being misread
+	_, ok, err := jsonStore.Get(ctx, "n")
+	assert.Error(t, err)
+	assert.False(t, ok)
+}
@@ -0,0 +1,73 @@
+package cachify
+
+import (
+	"container/list"
+	"time"
+)
+
+// Merge copies every live entry of another cache into the cache.
+//
+// Parameters:
+//   - other: The cache whose entries are copied. It is not modified.
+//   - conflict: A function resolving keys present in both caches; it receives the key, the current value (a),
+//     and the incoming value (b), and returns the value to keep. If nil, the incoming value wins.
+//
+// Returns:
+//   - The number of entries written.
+//
+// Details:
+//   - Entries are applied from the least to the most recently used in `other`, so its recency order is kept.
+//   - All writes happen under a single lock; quotas and capacity are then enforced, evicting per policy.
+//   - Merged entries receive the expiration of this cache, and their keys go through its key transform.
+func (c *LRU) Merge(other *LRU, conflict func(key string, a, b interface{}) interface{}) int {
+	if other == nil || other == c {
+		return 0
+	}
+	incoming := other.Snapshot()
+
+	now := time.Now()
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	written := 0
+	var last *list.Element
+	for i := len(incoming) - 1; i >= 0; i-- {
+		if !incoming[i].Expiration.IsZero() && now.After(incoming[i].Expiration) {
+			continue
+		}
+		key, value := c.normalizeKey(incoming[i].Key), incoming[i].Value
+		if conflict != nil {
+			if current, ok := c.liveEntry(key); ok {
+				value = conflict(key, decompress(current.value), value)
+			}
+		}
+		c.set(key, value)
+		c.enforceQuotas(key)
+		last = c.cache[key]
+		written++
+	}
+	c.enforceCapacity(last)
+	return written
+}
+
+// SetFromMap inserts or updates every key-value pair of a map.
+//
+// Parameters:
+//   - m: The key-value pairs to write.
+//
+// Details:
+//   - All writes happen under a single lock; quotas and capacity are then enforced, evicting per policy.
+//   - Map iteration order is random, so the relative recency of the inserted keys is unspecified.
+//   - Keys go through the key transform (see WithKeyTransform), like Set.
+func (c *LRU) SetFromMap(m map[string]interface{}) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	var last *list.Element
+	for key, value := range m {
+		key = c.normalizeKey(key)
+		c.set(key, value)
+		c.enforceQuotas(key)
+		last = c.cache[key]
+	}
+	c.enforceCapacity(last)
+}
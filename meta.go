@@ -0,0 +1,57 @@
+package cachify
+
+// SetWithMeta inserts or updates a key-value pair and attaches metadata to it.
+//
+// Parameters:
+//   - key: The key to be added or updated.
+//   - value: The value to be associated with the key.
+//   - meta: Arbitrary metadata such as the source, version, or etag of the value. It replaces any metadata
+//     previously attached to the key; nil removes it.
+//
+// Details:
+//   - Behaves like Set regarding recency, expiration, and capacity eviction.
+//   - A plain Set on a key keeps its existing metadata.
+//   - The map is copied, so later changes by the caller do not affect the entry.
+func (c *LRU) SetWithMeta(key string, value interface{}, meta map[string]string) {
+	key = c.normalizeKey(key)
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry := c.set(key, value)
+	if entry == nil {
+		return
+	}
+	entry.meta = copyMeta(meta)
+	c.enforceQuotas(key)
+	c.enforceCapacity(c.cache[key])
+}
+
+// Meta returns the metadata attached to a key.
+//
+// Returns:
+//   - A copy of the metadata, or nil if the key does not exist or has none.
+//
+// Details:
+//   - Does not affect recency; the same metadata is available from GetStateByKey via Meta().
+func (c *LRU) Meta(key string) map[string]string {
+	key = c.normalizeKey(key)
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	if element, exists := c.cache[key]; exists {
+		return copyMeta(element.Value.(*entries).meta)
+	}
+	return nil
+}
+
+// copyMeta returns a copy of a metadata map, or nil if it is empty.
+func copyMeta(meta map[string]string) map[string]string {
+	if len(meta) == 0 {
+		return nil
+	}
+	copied := make(map[string]string, len(meta))
+	for k, v := range meta {
+		copied[k] = v
+	}
+	return copied
+}
@@ -0,0 +1,249 @@
+package cachify
+
+import (
+	"container/list"
+	"strings"
+)
+
+// Namespace returns a view whose operations are scoped to keys starting with the given prefix.
+//
+// Parameters:
+//   - prefix: The namespace prefix, e.g. "tenant:42:". Include a separator to avoid overlaps.
+//
+// Returns:
+//   - A pointer to a View sharing this cache's storage and capacity.
+func (c *LRU) Namespace(prefix string) *View {
+	return &View{
+		cache:  c,
+		prefix: prefix,
+	}
+}
+
+// Namespace returns a nested view whose prefix is appended to this view's prefix.
+func (v *View) Namespace(prefix string) *View {
+	return v.cache.Namespace(v.prefix + prefix)
+}
+
+// Prefix returns the namespace prefix of the view.
+func (v *View) Prefix() string {
+	return v.prefix
+}
+
+// Cache returns the shared underlying cache.
+func (v *View) Cache() *LRU {
+	return v.cache
+}
+
+// Get retrieves the value associated with a key in the namespace.
+func (v *View) Get(key string) (value interface{}, ok bool) {
+	return v.cache.Get(v.prefix + key)
+}
+
+// Set inserts or updates a key-value pair in the namespace.
+func (v *View) Set(key string, value interface{}) {
+	v.cache.Set(v.prefix+key, value)
+}
+
+// Update updates the value associated with an existing key in the namespace.
+func (v *View) Update(key string, value interface{}) {
+	v.cache.Update(v.prefix+key, value)
+}
+
+// Remove deletes a key from the namespace.
+func (v *View) Remove(key string) {
+	v.cache.Remove(v.prefix + key)
+}
+
+// Contains checks if a key exists in the namespace without updating its access time.
+func (v *View) Contains(key string) bool {
+	return v.cache.Contains(v.prefix + key)
+}
+
+// Len returns the number of entries in the namespace.
+func (v *View) Len() int {
+	return v.cache.LenByPrefix(v.prefix)
+}
+
+// Keys returns the keys of the namespace, without the prefix, in lexical order.
+func (v *View) Keys() []string {
+	keys := v.cache.KeysByPrefix(v.prefix)
+	for i, key := range keys {
+		keys[i] = strings.TrimPrefix(key, v.prefix)
+	}
+	return keys
+}
+
+// Clear removes every entry of the namespace, leaving other namespaces untouched.
+//
+// Returns:
+//   - The number of entries removed.
+func (v *View) Clear() int {
+	return v.cache.RemoveByPrefix(v.prefix)
+}
+
+// SetQuota limits the number of entries a namespace may hold.
+//
+// Parameters:
+//   - maxEntries: The maximum number of entries in the namespace. Zero or less removes the quota.
+//
+// Details:
+//   - See LRU.SetQuota.
+func (v *View) SetQuota(maxEntries int) {
+	v.cache.SetQuota(v.prefix, maxEntries)
+}
+
+// Quota returns the entry quota of the namespace, or 0 if it has none.
+func (v *View) Quota() int {
+	return v.cache.Quota(v.prefix)
+}
+
+// SetQuota limits the number of entries whose key starts with a prefix.
+//
+// Parameters:
+//   - prefix: The namespace prefix.
+//   - maxEntries: The maximum number of entries under the prefix. Zero or less removes the quota.
+//
+// Details:
+//   - When an insert pushes a namespace over its quota, the least recently used entries of that
+//     namespace are evicted, so one tenant cannot evict everyone else's entries.
+//   - Lowering a quota evicts the namespace's excess entries immediately.
+//   - Quotas bound entry counts only; the overall capacity still applies on top of them.
+func (c *LRU) SetQuota(prefix string, maxEntries int) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if maxEntries <= 0 {
+		delete(c.quotas, prefix)
+		return
+	}
+	if c.quotas == nil {
+		c.quotas = make(map[string]*quota)
+	}
+	q, exists := c.quotas[prefix]
+	if !exists {
+		q = newQuota(maxEntries)
+		c.quotas[prefix] = q
+		c.orderQuota(prefix, q)
+	}
+	q.maxEntries = maxEntries
+	c.shrinkNamespace(q)
+}
+
+// Quota returns the entry quota of a prefix, or 0 if it has none.
+func (c *LRU) Quota(prefix string) int {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	if q, exists := c.quotas[prefix]; exists {
+		return q.maxEntries
+	}
+	return 0
+}
+
+// enforceQuotas evicts least recently used entries from every namespace of the key that exceeds its quota.
+//
+// Details:
+//   - Must be called with the write lock held.
+func (c *LRU) enforceQuotas(key string) {
+	for prefix, q := range c.quotas {
+		if strings.HasPrefix(key, prefix) {
+			c.shrinkNamespace(q)
+		}
+	}
+}
+
+// shrinkNamespace evicts the namespace's least recently used entries until it holds at most its quota.
+//
+// Details:
+//   - Must be called with the write lock held.
+//   - Walks the namespace's own recency order from the back, so the cost depends on the namespace
+//     alone. Pinned entries are skipped.
+//   - Evicted keys are remembered by the ghost list, like capacity evictions.
+func (c *LRU) shrinkNamespace(q *quota) {
+	excess := q.order.Len() - q.maxEntries
+	for node := q.order.Back(); node != nil && excess > 0; {
+		prev := node.Prev()
+		element := node.Value.(*list.Element)
+		if entry := element.Value.(*entries); !entry.pinned {
+			c.remember(entry.key)
+			c.stats.evict()
+			c.drop(element, EventEvict)
+			excess--
+		}
+		node = prev
+	}
+}
+
+// newQuota creates a quota with an empty recency order.
+func newQuota(maxEntries int) *quota {
+	return &quota{
+		maxEntries: maxEntries,
+		order:      list.New(),
+		index:      make(map[*list.Element]*list.Element),
+	}
+}
+
+// linkQuotas adds a new element to the front of every namespace order covering its key.
+//
+// Details:
+//   - Must be called with the write lock held.
+func (c *LRU) linkQuotas(element *list.Element) {
+	if len(c.quotas) == 0 {
+		return
+	}
+	key := element.Value.(*entries).key
+	for prefix, q := range c.quotas {
+		if strings.HasPrefix(key, prefix) {
+			q.index[element] = q.order.PushFront(element)
+		}
+	}
+}
+
+// unlinkQuotas removes an element from every namespace order holding it.
+//
+// Details:
+//   - Must be called with the write lock held.
+func (c *LRU) unlinkQuotas(element *list.Element) {
+	for _, q := range c.quotas {
+		if node, exists := q.index[element]; exists {
+			q.order.Remove(node)
+			delete(q.index, element)
+		}
+	}
+}
+
+// promote moves an element to the front of the recency list and of its namespace orders.
+//
+// Details:
+//   - Must be called with the write lock held.
+func (c *LRU) promote(element *list.Element) {
+	c.list.MoveToFront(element)
+	for _, q := range c.quotas {
+		if node, exists := q.index[element]; exists {
+			q.order.MoveToFront(node)
+		}
+	}
+}
+
+// orderQuotas rebuilds every namespace order from the recency list, after bulk changes to the list.
+//
+// Details:
+//   - Must be called with the write lock held.
+func (c *LRU) orderQuotas() {
+	for prefix, q := range c.quotas {
+		c.orderQuota(prefix, q)
+	}
+}
+
+// orderQuota rebuilds the order of one namespace from the recency list.
+//
+// Details:
+//   - Must be called with the write lock held.
+func (c *LRU) orderQuota(prefix string, q *quota) {
+	q.order.Init()
+	clear(q.index)
+	for element := c.list.Front(); element != nil; element = element.Next() {
+		if strings.HasPrefix(element.Value.(*entries).key, prefix) {
+			q.index[element] = q.order.PushBack(element)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+// Package natsadapter provides NATS-backed implementations of the cachify extension points.
+package natsadapter
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/nats-io/nats.go"
+	"github.com/pnguyen215/cachify"
+)
+
+// Broadcaster is a cachify.Broadcaster backed by NATS core publish/subscribe.
+//
+// Fields:
+//   - conn: The NATS connection used to publish and subscribe.
+//   - subject: The subject shared by all instances.
+//   - mutex: A lock protecting the active subscriptions.
+//   - subs: The subscriptions opened by Subscribe, drained by Close.
+type Broadcaster struct {
+	conn    *nats.Conn
+	subject string
+	mutex   sync.Mutex
+	subs    []*nats.Subscription
+}
+
+var _ cachify.Broadcaster = (*Broadcaster)(nil)
+
+// NewBroadcaster creates a new NATS broadcaster.
+//
+// Parameters:
+//   - conn: An established NATS connection. It is not closed by Close.
+//   - subject: The subject name, e.g. "cachify.invalidate.users".
+//
+// Returns:
+//   - A pointer to an initialized Broadcaster.
+func NewBroadcaster(conn *nats.Conn, subject string) *Broadcaster {
+	return &Broadcaster{
+		conn:    conn,
+		subject: subject,
+	}
+}
+
+// Publish sends an invalidation message to the subject as JSON.
+//
+// Details:
+//   - NATS core publishing is asynchronous; ctx is only checked before the call.
+func (b *Broadcaster) Publish(ctx context.Context, msg cachify.Invalidation) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return b.conn.Publish(b.subject, data)
+}
+
+// Subscribe subscribes to the subject and delivers every decoded message to the handler.
+//
+// Details:
+//   - Flushes the connection so the subscription is registered with the server before returning.
+//     A context without a deadline, such as the one NewInvalidator passes, uses the connection's
+//     default flush timeout.
+//   - Malformed messages are skipped.
+func (b *Broadcaster) Subscribe(ctx context.Context, handler func(msg cachify.Invalidation)) error {
+	sub, err := b.conn.Subscribe(b.subject, func(m *nats.Msg) {
+		var msg cachify.Invalidation
+		if err := json.Unmarshal(m.Data, &msg); err != nil {
+			return
+		}
+		handler(msg)
+	})
+	if err != nil {
+		return err
+	}
+	if err := b.flush(ctx); err != nil {
+		_ = sub.Unsubscribe()
+		return err
+	}
+	b.mutex.Lock()
+	b.subs = append(b.subs, sub)
+	b.mutex.Unlock()
+	return nil
+}
+
+// Close drains every subscription opened by Subscribe.
+func (b *Broadcaster) Close() error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	var err error
+	for _, sub := range b.subs {
+		if e := sub.Drain(); e != nil && err == nil {
+			err = e
+		}
+	}
+	b.subs = nil
+	return err
+}
+
+// flush waits for the server to process every buffered command, bounded by the context deadline or,
+// without one, by the connection's default timeout, since FlushWithContext rejects contexts without
+// a deadline.
+func (b *Broadcaster) flush(ctx context.Context) error {
+	if _, ok := ctx.Deadline(); !ok {
+		return b.conn.Flush()
+	}
+	return b.conn.FlushWithContext(ctx)
+}
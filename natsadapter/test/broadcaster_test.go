@@ -0,0 +1,63 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats-server/v2/server"
+	natsserver "github.com/nats-io/nats-server/v2/test"
+	"github.com/nats-io/nats.go"
+	"github.com/pnguyen215/cachify"
+	"github.com/pnguyen215/cachify/natsadapter"
+	"github.com/stretchr/testify/assert"
+)
+
+// newNATS starts an in-process NATS server and returns a connection to it.
+func newNATS(t *testing.T) *nats.Conn {
+	opts := natsserver.DefaultTestOptions
+	opts.Port = server.RANDOM_PORT
+	srv := natsserver.RunServer(&opts)
+	t.Cleanup(srv.Shutdown)
+	conn, err := nats.Connect(srv.ClientURL())
+	assert.NoError(t, err)
+	t.Cleanup(conn.Close)
+	return conn
+}
+
+// Test invalidations published over NATS drop the key on peers but not on the sender
+func TestNATSBroadcaster(t *testing.T) {
+	conn := newNATS(t)
+	a, err := cachify.NewInvalidator(cachify.NewLRU(10), natsadapter.NewBroadcaster(conn, "invalidate"))
+	assert.NoError(t, err)
+	defer a.Close()
+	b, err := cachify.NewInvalidator(cachify.NewLRU(10), natsadapter.NewBroadcaster(conn, "invalidate"))
+	assert.NoError(t, err)
+
+	b.Cache().Set("k", "stale")
+	a.Set("k", "fresh")
+	assert.Eventually(t, func() bool { return !b.Cache().Contains("k") }, time.Second, time.Millisecond)
+	value, ok := a.Get("k")
+	assert.True(t, ok)
+	assert.Equal(t, "fresh", value)
+
+	// Malformed payloads are skipped without stopping delivery
+	assert.NoError(t, conn.Publish("invalidate", []byte("not json")))
+	b.Cache().Set("k", "stale")
+	a.Invalidate("k")
+	assert.Eventually(t, func() bool { return !b.Cache().Contains("k") }, time.Second, time.Millisecond)
+
+	// A closed broadcaster stops delivering
+	assert.NoError(t, b.Close())
+	assert.NoError(t, conn.Flush())
+	b.Cache().Set("k", "kept")
+	assert.NoError(t, a.InvalidateContext(context.Background(), "k"))
+	assert.NoError(t, conn.Flush())
+	time.Sleep(20 * time.Millisecond)
+	assert.True(t, b.Cache().Contains("k"))
+
+	// A cancelled context fails the publish
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, a.InvalidateContext(ctx, "k"), context.Canceled)
+}
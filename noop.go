@@ -0,0 +1,104 @@
+package cachify
+
+import (
+	"context"
+)
+
+var (
+	_ Cache = (*Noop)(nil)
+	_ Cache = (*PassThrough)(nil)
+)
+
+// NewNoop creates a cache that never stores anything.
+//
+// Returns:
+//   - A pointer to a Noop cache; every Get misses and every write is discarded.
+func NewNoop() *Noop {
+	return &Noop{}
+}
+
+// Get always reports a miss.
+func (n *Noop) Get(key string) (value interface{}, ok bool) {
+	n.stats.miss()
+	return nil, false
+}
+
+// Set discards the value.
+func (n *Noop) Set(key string, value interface{}) {}
+
+// Remove does nothing.
+func (n *Noop) Remove(key string) {}
+
+// Len always returns zero.
+func (n *Noop) Len() int { return 0 }
+
+// Clear does nothing.
+func (n *Noop) Clear() {}
+
+// Contains always returns false.
+func (n *Noop) Contains(key string) bool { return false }
+
+// Stats returns the miss counter.
+func (n *Noop) Stats() Stats { return n.stats.snapshot(0) }
+
+// Close does nothing.
+func (n *Noop) Close() {}
+
+// NewPassThrough creates a loading cache that calls the Loader on every lookup and stores nothing.
+//
+// Parameters:
+//   - loader: The function invoked on every lookup.
+//
+// Returns:
+//   - A pointer to an initialized PassThrough cache.
+func NewPassThrough(loader Loader) *PassThrough {
+	return &PassThrough{loader: loader}
+}
+
+// Get loads the value of a key with a background context.
+//
+// Returns:
+//   - The loaded value, and false if the Loader failed.
+func (p *PassThrough) Get(key string) (value interface{}, ok bool) {
+	value, err := p.GetContext(context.Background(), key)
+	return value, err == nil
+}
+
+// GetContext loads the value of a key, matching the signature of Loading.Get.
+//
+// Parameters:
+//   - ctx: The context passed to the Loader.
+//   - key: The key to load.
+//
+// Returns:
+//   - The loaded value, or the Loader's error.
+func (p *PassThrough) GetContext(ctx context.Context, key string) (interface{}, error) {
+	value, err := p.loader(ctx, key)
+	if err != nil {
+		p.stats.miss()
+		return nil, err
+	}
+	p.stats.hit()
+	return value, nil
+}
+
+// Set discards the value.
+func (p *PassThrough) Set(key string, value interface{}) {}
+
+// Remove does nothing.
+func (p *PassThrough) Remove(key string) {}
+
+// Len always returns zero.
+func (p *PassThrough) Len() int { return 0 }
+
+// Clear does nothing.
+func (p *PassThrough) Clear() {}
+
+// Contains always returns false, since nothing is stored.
+func (p *PassThrough) Contains(key string) bool { return false }
+
+// Stats returns the load counters; a successful load counts as a hit.
+func (p *PassThrough) Stats() Stats { return p.stats.snapshot(0) }
+
+// Close does nothing.
+func (p *PassThrough) Close() {}
@@ -0,0 +1,83 @@
+package cachify
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+// WithPanicCallback sets the callback notified when a user callback panics.
+//
+// Parameters:
+//   - callback: The function receiving the key and a *PanicError, typically a logger; nil removes it.
+//
+// Returns:
+//   - The LRU cache, for chaining.
+//
+// Details:
+//   - The eviction, expiration, and event callbacks, and the Loader of a Loading cache wrapping this LRU,
+//     are always run behind recover: a panic is counted in Stats().Panics and reported here instead
+//     of crashing the background cleanup or escaping the cache operation.
+//   - The panicking callback is abandoned, but the cache operation completes and the lock is released.
+//   - The callback runs with the write lock held, except for loader panics, and must not panic itself.
+func (c *LRU) WithPanicCallback(callback OnErrorCallback) *LRU {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.onPanic = callback
+	return c
+}
+
+// SetPanicCallback sets the callback notified when the eviction callback panics.
+//
+// Details:
+//   - A panicking eviction callback is recovered and counted in Stats().Panics either way.
+func (c *Clock) SetPanicCallback(callback OnErrorCallback) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.onPanic = callback
+}
+
+// SetPanicCallback sets the callback notified when the eviction callback panics.
+//
+// Details:
+//   - A panicking eviction callback is recovered and counted in Stats().Panics either way.
+func (c *LRUK) SetPanicCallback(callback OnErrorCallback) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.onPanic = callback
+}
+
+// Error describes the recovered panic.
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("cachify: %s callback panicked for %q: %v", e.Hook, e.Key, e.Value)
+}
+
+// Unwrap returns ErrPanic, so the error matches it with errors.Is.
+func (e *PanicError) Unwrap() error {
+	return ErrPanic
+}
+
+// invoke runs a user callback, recovering a panic.
+//
+// Parameters:
+//   - stats: The counters where the panic is counted.
+//   - onPanic: The callback notified of the panic, or nil.
+//   - hook: The name of the callback, reported in the PanicError.
+//   - key: The key the callback is invoked for.
+//   - fn: The callback invocation.
+//
+// Returns:
+//   - A *PanicError if fn panicked, nil otherwise.
+func invoke(stats *counters, onPanic OnErrorCallback, hook, key string, fn func()) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			stats.panics.Add(1)
+			panicErr := &PanicError{Hook: hook, Key: key, Value: r, Stack: debug.Stack()}
+			if onPanic != nil {
+				onPanic(key, panicErr)
+			}
+			err = panicErr
+		}
+	}()
+	fn()
+	return nil
+}
@@ -0,0 +1,222 @@
+package cachify
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"encoding/gob"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// SaveToFile writes a snapshot of the cache to a file.
+//
+// Parameters:
+//   - path: The destination file.
+//
+// Returns:
+//   - An error if the snapshot cannot be encoded or written.
+//
+// Details:
+//   - Values are encoded with the cache codec (see WithCodec); with the default GobCodec,
+//     custom value types must be registered with gob.Register.
+//   - When encryption is enabled (see WithEncryption), the whole file is sealed, keys and metadata included.
+//   - The data is written to a temporary file in the same directory and atomically renamed over `path`,
+//     so a crash never leaves a truncated file behind.
+func (c *LRU) SaveToFile(path string) error {
+	return WriteSnapshotFile(path, c.Snapshot(), c.Codec(), c.cipher())
+}
+
+// LoadFromFile replaces the content of the cache with a snapshot previously written by SaveToFile.
+//
+// Parameters:
+//   - path: The snapshot file.
+//
+// Returns:
+//   - The number of entries restored.
+//   - An error if the file cannot be read or decoded; the cache is left unchanged in that case.
+//     The file must have been written with the same codec and encryption key.
+//
+// Details:
+//   - Behaves like Restore: expired entries are skipped and recency order is preserved.
+func (c *LRU) LoadFromFile(path string) (int, error) {
+	snapshot, err := ReadSnapshotFile(path, c.Codec(), c.cipher())
+	if err != nil {
+		return 0, err
+	}
+	return c.Restore(snapshot), nil
+}
+
+// WriteSnapshotFile writes entries to a file in the binary format of SaveToFile, without a cache.
+//
+// Parameters:
+//   - path: The destination file, written atomically.
+//   - snapshot: The entries, most recently used first.
+//   - codec: The codec encoding the values.
+//   - aead: An optional cipher sealing the whole file; nil writes it in the clear.
+//
+// Returns:
+//   - An error if the entries cannot be encoded or written.
+func WriteSnapshotFile(path string, snapshot []Entry, codec Codec, aead cipher.AEAD) error {
+	records := make([]fileEntry, 0, len(snapshot))
+	for _, e := range snapshot {
+		data, err := codec.Marshal(e.Value)
+		if err != nil {
+			return fmt.Errorf("cachify: encode %q: %w", e.Key, err)
+		}
+		records = append(records, fileEntry{
+			Key:         e.Key,
+			Value:       data,
+			Expiration:  e.Expiration,
+			AccessTime:  e.AccessTime,
+			AccessCount: e.AccessCount,
+			Tags:        e.Tags,
+			Pinned:      e.Pinned,
+			Meta:        e.Meta,
+			Persistent:  e.Persistent,
+			Deadline:    e.Deadline,
+		})
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(records); err != nil {
+		return err
+	}
+	data := buf.Bytes()
+	if aead != nil {
+		sealed, err := seal(aead, data)
+		if err != nil {
+			return err
+		}
+		data = sealed
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// ReadSnapshotFile reads the entries of a file written by SaveToFile, without a cache.
+//
+// Parameters:
+//   - path: The snapshot file.
+//   - codec: The codec the values were encoded with.
+//   - aead: The cipher the file was sealed with, or nil if it is not encrypted.
+//
+// Returns:
+//   - The entries, most recently used first, including expired ones.
+//   - An error if the file cannot be read or decoded.
+func ReadSnapshotFile(path string, codec Codec, aead cipher.AEAD) ([]Entry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if aead != nil {
+		if data, err = unseal(aead, data); err != nil {
+			return nil, err
+		}
+	}
+
+	var records []fileEntry
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&records); err != nil {
+		return nil, err
+	}
+	snapshot := make([]Entry, 0, len(records))
+	for _, record := range records {
+		var value interface{}
+		if err := codec.Unmarshal(record.Value, &value); err != nil {
+			return nil, fmt.Errorf("cachify: decode %q: %w", record.Key, err)
+		}
+		snapshot = append(snapshot, Entry{
+			Key:         record.Key,
+			Value:       value,
+			Expiration:  record.Expiration,
+			AccessTime:  record.AccessTime,
+			AccessCount: record.AccessCount,
+			Tags:        record.Tags,
+			Pinned:      record.Pinned,
+			Meta:        record.Meta,
+			Persistent:  record.Persistent,
+			Deadline:    record.Deadline,
+		})
+	}
+	return snapshot, nil
+}
+
+// WithAutoSnapshot persists the cache to a file periodically and on Close.
+//
+// Parameters:
+//   - path: The destination file, written atomically (see SaveToFile).
+//   - interval: The time between snapshots. Zero or negative only snapshots on Close.
+//   - onError: An optional callback invoked with the path when a snapshot fails.
+//
+// Returns:
+//   - The LRU cache, for chaining.
+//
+// Details:
+//   - Combined with LoadFromFile at startup, this gives warm restarts.
+//   - Replaces any previously configured auto-snapshot.
+func (c *LRU) WithAutoSnapshot(path string, interval time.Duration, onError OnErrorCallback) *LRU {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.stopAutoSnapshot()
+	c.snapshotPath = path
+	c.onSnapshotError = onError
+	if !c.closed && interval > 0 {
+		c.stopSnapshot = make(chan struct{})
+		go c.startAutoSnapshot(c.stopSnapshot, path, interval)
+	}
+	return c
+}
+
+// startAutoSnapshot writes a snapshot on every tick until stop is closed.
+func (c *LRU) startAutoSnapshot(stop chan struct{}, path string, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			c.autoSnapshot(path)
+		case <-stop:
+			return
+		}
+	}
+}
+
+// autoSnapshot writes a snapshot and reports failures to the snapshot error callback.
+func (c *LRU) autoSnapshot(path string) {
+	if err := c.SaveToFile(path); err != nil {
+		c.mutex.RLock()
+		onError := c.onSnapshotError
+		c.mutex.RUnlock()
+		if onError != nil {
+			onError(path, err)
+		}
+	}
+}
+
+// stopAutoSnapshot stops the background snapshot goroutine, if running, and returns the configured path.
+// It must be called with the lock held.
+func (c *LRU) stopAutoSnapshot() string {
+	if c.stopSnapshot != nil {
+		close(c.stopSnapshot)
+		c.stopSnapshot = nil
+	}
+	return c.snapshotPath
+}
@@ -0,0 +1,59 @@
+package cachify
+
+// Pin exempts a key from capacity-based eviction.
+//
+// Parameters:
+//   - key: The key to pin.
+//
+// Returns:
+//   - true if the key exists and is now pinned, false if it does not exist.
+//
+// Details:
+//   - Pinned entries are skipped when the cache or a namespace exceeds its capacity,
+//     but they still honor explicit removal (Remove, Clear, InvalidateTag, ...) and expiration.
+//   - Overwriting a pinned key with Set keeps it pinned.
+func (c *LRU) Pin(key string) bool {
+	key = c.normalizeKey(key)
+	return c.setPinned(key, true)
+}
+
+// Unpin makes a pinned key eligible for capacity-based eviction again.
+//
+// Returns:
+//   - true if the key exists, false otherwise.
+//
+// Details:
+//   - If the cache is over capacity because of pinned entries, it is trimmed immediately.
+func (c *LRU) Unpin(key string) bool {
+	key = c.normalizeKey(key)
+	return c.setPinned(key, false)
+}
+
+// IsPinned checks if a key is pinned.
+func (c *LRU) IsPinned(key string) bool {
+	key = c.normalizeKey(key)
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	if element, exists := c.cache[key]; exists {
+		return element.Value.(*entries).pinned
+	}
+	return false
+}
+
+// setPinned updates the pinned flag of a key and re-applies the capacity.
+func (c *LRU) setPinned(key string, pinned bool) bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	element, exists := c.cache[key]
+	if !exists {
+		return false
+	}
+	element.Value.(*entries).pinned = pinned
+	if !pinned {
+		c.enforceQuotas(key)
+		c.enforceCapacity(nil)
+	}
+	return true
+}
@@ -0,0 +1,26 @@
+package cachify
+
+// WithEvictionPolicy sets which entry capacity-based eviction removes.
+//
+// Parameters:
+//   - policy: EvictLRU (the default) or EvictMRU.
+//
+// Returns:
+//   - The LRU cache, for chaining.
+//
+// Details:
+//   - Both policies share the same recency list; only the victim selection is inverted.
+//   - Namespace quotas keep evicting the least recently used entry of the namespace.
+func (c *LRU) WithEvictionPolicy(policy EvictionPolicy) *LRU {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.policy = policy
+	return c
+}
+
+// EvictionPolicy returns the policy used by capacity-based eviction.
+func (c *LRU) EvictionPolicy() EvictionPolicy {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.policy
+}
@@ -0,0 +1,155 @@
+package cachify
+
+import (
+	"sort"
+)
+
+// RemoveByPrefix removes every entry whose key starts with the given prefix.
+//
+// Parameters:
+//   - prefix: The key prefix, e.g. "user:42:".
+//
+// Returns:
+//   - The number of entries removed.
+//
+// Details:
+//   - Uses the internal key trie, built on the first prefix-scoped call and maintained afterwards.
+//   - The eviction callback is invoked for every removed entry.
+func (c *LRU) RemoveByPrefix(prefix string) int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	keys := c.prefixIndex().keys(prefix)
+	for _, key := range keys {
+		if element, exists := c.cache[key]; exists {
+			c.evict(element)
+		}
+	}
+	return len(keys)
+}
+
+// LenByPrefix returns the number of entries whose key starts with the given prefix.
+//
+// Details:
+//   - Runs in O(len(prefix)) once the key trie is built.
+func (c *LRU) LenByPrefix(prefix string) int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if node := c.prefixIndex().find(prefix); node != nil {
+		return node.count
+	}
+	return 0
+}
+
+// KeysByPrefix returns the keys starting with the given prefix in lexical order.
+//
+// Details:
+//   - Does not modify the order of items in the cache.
+func (c *LRU) KeysByPrefix(prefix string) []string {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.prefixIndex().keys(prefix)
+}
+
+// prefixIndex returns the key trie, building it from the current keys on first use.
+//
+// Details:
+//   - Must be called with the write lock held.
+func (c *LRU) prefixIndex() *prefixNode {
+	if c.prefixes == nil {
+		c.prefixes = &prefixNode{}
+		for key := range c.cache {
+			c.prefixes.insert(key)
+		}
+	}
+	return c.prefixes
+}
+
+// insert adds a key to the trie.
+func (n *prefixNode) insert(key string) {
+	path := make([]*prefixNode, 0, len(key)+1)
+	node := n
+	for i := 0; i < len(key); i++ {
+		path = append(path, node)
+		if node.children == nil {
+			node.children = make(map[byte]*prefixNode)
+		}
+		child, exists := node.children[key[i]]
+		if !exists {
+			child = &prefixNode{}
+			node.children[key[i]] = child
+		}
+		node = child
+	}
+	if node.terminal {
+		return
+	}
+	node.terminal = true
+	node.count++
+	for _, p := range path {
+		p.count++
+	}
+}
+
+// remove deletes a key from the trie, pruning empty branches.
+func (n *prefixNode) remove(key string) {
+	path := make([]*prefixNode, 0, len(key)+1)
+	node := n
+	for i := 0; i < len(key); i++ {
+		path = append(path, node)
+		node = node.children[key[i]]
+		if node == nil {
+			return
+		}
+	}
+	if !node.terminal {
+		return
+	}
+	node.terminal = false
+	node.count--
+	for i := len(path) - 1; i >= 0; i-- {
+		parent := path[i]
+		parent.count--
+		if child := parent.children[key[i]]; child.count == 0 {
+			delete(parent.children, key[i])
+		}
+	}
+}
+
+// find returns the node reached by following the prefix, or nil.
+func (n *prefixNode) find(prefix string) *prefixNode {
+	node := n
+	for i := 0; i < len(prefix) && node != nil; i++ {
+		node = node.children[prefix[i]]
+	}
+	return node
+}
+
+// keys returns every key below the prefix in lexical order.
+func (n *prefixNode) keys(prefix string) []string {
+	node := n.find(prefix)
+	if node == nil {
+		return nil
+	}
+	keys := make([]string, 0, node.count)
+	buf := []byte(prefix)
+	var walk func(node *prefixNode)
+	walk = func(node *prefixNode) {
+		if node.terminal {
+			keys = append(keys, string(buf))
+		}
+		next := make([]byte, 0, len(node.children))
+		for b := range node.children {
+			next = append(next, b)
+		}
+		sort.Slice(next, func(i, j int) bool { return next[i] < next[j] })
+		for _, b := range next {
+			buf = append(buf, b)
+			walk(node.children[b])
+			buf = buf[:len(buf)-1]
+		}
+	}
+	walk(node)
+	return keys
+}
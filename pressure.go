@@ -0,0 +1,206 @@
+package cachify
+
+import (
+	"math"
+	"runtime/debug"
+	"runtime/metrics"
+	"sync"
+	"time"
+)
+
+// Shed evicts a fraction of the entries, least recently used first, without changing the capacity.
+//
+// Parameters:
+//   - fraction: The share of the current entries to evict, between 0 and 1. Any positive fraction
+//     evicts at least one entry.
+//
+// Returns:
+//   - The number of entries evicted.
+//
+// Details:
+//   - Evictions go through the eviction callback, are reported as EventEvict, and count in Stats().Evictions;
+//     expired entries among them are removed as expirations instead.
+//   - Pinned entries are skipped, so fewer entries may be evicted when many are pinned.
+func (c *LRU) Shed(fraction float64) int {
+	if fraction <= 0 {
+		return 0
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	n := int(math.Ceil(float64(len(c.cache)) * min(fraction, 1)))
+	return c.evictOldest(n, nil)
+}
+
+// EvictOldest removes the n least recently used entries in one locked pass.
+//
+// Parameters:
+//   - n: The number of entries to remove.
+//
+// Returns:
+//   - The removed entries, least recently used first, in the same form as Snapshot. Fewer than n
+//     are returned when the cache holds fewer unpinned entries.
+//
+// Details:
+//   - Meant to shed load quickly, e.g. when a downstream dependency degrades.
+//   - Like Shed, evictions go through the eviction callback, are reported as EventEvict, and count in
+//     Stats().Evictions; expired entries are removed and returned too, but counted as expirations.
+//   - Pinned entries are skipped.
+func (c *LRU) EvictOldest(n int) []Entry {
+	if n <= 0 {
+		return []Entry{}
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	removed := make([]Entry, 0, min(n, len(c.cache)))
+	c.evictOldest(n, &removed)
+	return removed
+}
+
+// WithMemoryPressure sheds entries whenever a memory-pressure signal is received.
+//
+// Parameters:
+//   - signal: The channel signalling pressure, e.g. from WatchMemoryLimit or an application's own monitor.
+//     Nil stops listening.
+//   - fraction: The share of the entries evicted per signal (see Shed).
+//
+// Returns:
+//   - The LRU cache, for chaining.
+//
+// Details:
+//   - A background goroutine listens until the channel is closed, the listener is replaced, or the cache is closed.
+func (c *LRU) WithMemoryPressure(signal <-chan struct{}, fraction float64) *LRU {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.stopMemoryPressure()
+	if !c.closed && signal != nil {
+		c.stopPressure = make(chan struct{})
+		go c.listenMemoryPressure(c.stopPressure, signal, fraction)
+	}
+	return c
+}
+
+// WatchMemoryLimit signals when the memory used by the process approaches its runtime memory limit.
+//
+// Parameters:
+//   - interval: How often the memory is sampled. Zero or less uses one second.
+//   - threshold: The fraction of the limit above which pressure is signalled, e.g. 0.9.
+//
+// Returns:
+//   - A channel receiving a signal after every sample above the threshold, for WithMemoryPressure.
+//     Signals are dropped while a previous one is still pending.
+//   - A function stopping the watch and closing the channel.
+//
+// Details:
+//   - The limit is the one set with debug.SetMemoryLimit or GOMEMLIMIT, read on every sample;
+//     without one, nothing is signalled.
+//   - Memory is measured by memoryInUse, without stopping the world.
+func WatchMemoryLimit(interval time.Duration, threshold float64) (<-chan struct{}, func()) {
+	if interval <= 0 {
+		interval = time.Second
+	}
+	signal := make(chan struct{}, 1)
+	stop := make(chan struct{})
+	go func() {
+		defer close(signal)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+			case <-stop:
+				return
+			}
+			limit := debug.SetMemoryLimit(-1)
+			if limit == math.MaxInt64 {
+				continue
+			}
+			if float64(memoryInUse()) > float64(limit)*threshold {
+				select {
+				case signal <- struct{}{}:
+				default:
+				}
+			}
+		}
+	}()
+	var once sync.Once
+	return signal, func() {
+		once.Do(func() { close(stop) })
+	}
+}
+
+// memoryInUse returns the memory the runtime counts against its memory limit: all memory it has
+// mapped minus the heap released to the operating system.
+//
+// Details:
+//   - Reads runtime/metrics, which, unlike runtime.ReadMemStats, does not stop the world.
+func memoryInUse() uint64 {
+	samples := []metrics.Sample{
+		{Name: "/memory/classes/total:bytes"},
+		{Name: "/memory/classes/heap/released:bytes"},
+	}
+	metrics.Read(samples)
+	return samples[0].Value.Uint64() - samples[1].Value.Uint64()
+}
+
+// listenMemoryPressure sheds entries on every signal until stop or signal is closed.
+func (c *LRU) listenMemoryPressure(stop chan struct{}, signal <-chan struct{}, fraction float64) {
+	for {
+		select {
+		case _, ok := <-signal:
+			if !ok {
+				return
+			}
+			c.Shed(fraction)
+		case <-stop:
+			return
+		}
+	}
+}
+
+// stopMemoryPressure stops the memory-pressure goroutine, if running.
+// It must be called with the lock held.
+func (c *LRU) stopMemoryPressure() {
+	if c.stopPressure != nil {
+		close(c.stopPressure)
+		c.stopPressure = nil
+	}
+}
+
+// evictOldest evicts up to n entries, least recently used first, skipping pinned entries.
+//
+// Parameters:
+//   - n: The maximum number of entries to evict.
+//   - report: If not nil, receives a copy of every evicted entry, in eviction order.
+//
+// Returns:
+//   - The number of entries evicted.
+//
+// Details:
+//   - Must be called with the write lock held.
+//   - Expired entries are evicted as expirations but count towards n.
+func (c *LRU) evictOldest(n int, report *[]Entry) int {
+	evicted := 0
+	now := time.Now()
+	for element := c.list.Back(); element != nil && evicted < n; {
+		previous := element.Prev()
+		entry := element.Value.(*entries)
+		if entry.pinned {
+			element = previous
+			continue
+		}
+		if report != nil {
+			*report = append(*report, c.entryOf(entry))
+		}
+		if expired(entry, now) {
+			c.expire(element)
+		} else {
+			c.remember(entry.key)
+			c.stats.evict()
+			c.drop(element, EventEvict)
+		}
+		evicted++
+		element = previous
+	}
+	return evicted
+}
@@ -0,0 +1,43 @@
+package cachify
+
+// ReadOnly returns a view of the cache exposing only reads.
+//
+// Returns:
+//   - A ReadOnlyCache backed by the cache; changes to the cache are visible through it.
+//
+// Details:
+//   - Reads through the view behave like reads on the cache, including recency updates and hit counting.
+func (c *LRU) ReadOnly() ReadOnlyCache {
+	return NewReadOnly(c)
+}
+
+// NewReadOnly returns a read-only view of any cache.
+//
+// Parameters:
+//   - cache: The cache to expose.
+//
+// Returns:
+//   - A ReadOnlyCache backed by the cache.
+func NewReadOnly(cache Cache) ReadOnlyCache {
+	return readOnly{cache: cache}
+}
+
+// Get retrieves the value associated with a given key.
+func (r readOnly) Get(key string) (value interface{}, ok bool) {
+	return r.cache.Get(key)
+}
+
+// Contains checks whether a key exists in the cache.
+func (r readOnly) Contains(key string) bool {
+	return r.cache.Contains(key)
+}
+
+// Len returns the number of items in the cache.
+func (r readOnly) Len() int {
+	return r.cache.Len()
+}
+
+// Stats returns the usage counters of the cache.
+func (r readOnly) Stats() Stats {
+	return r.cache.Stats()
+}
@@ -0,0 +1,242 @@
+package cachify
+
+import (
+	"container/list"
+	"sort"
+)
+
+// SeededHasher returns a Hasher that spreads keys differently for every seed.
+//
+// Parameters:
+//   - seed: The seed. Zero behaves like DefaultHasher.
+//
+// Returns:
+//   - A Hasher mixing the seed into the xxHash64 of the key.
+func SeededHasher(seed uint64) Hasher {
+	return seedHasher(DefaultHasher, seed)
+}
+
+// seedHasher returns a Hasher mixing a seed into the hash of another one.
+//
+// Parameters:
+//   - hasher: The hasher whose output is mixed.
+//   - seed: The seed. Zero returns hasher unchanged.
+func seedHasher(hasher Hasher, seed uint64) Hasher {
+	if seed == 0 {
+		return hasher
+	}
+	return func(key string) uint64 {
+		// The splitmix64 finalizer turns a seed change into an unrelated stripe assignment
+		h := hasher(key) ^ seed
+		h = (h ^ h>>30) * 0xbf58476d1ce4e5b9
+		h = (h ^ h>>27) * 0x94d049bb133111eb
+		return h ^ h>>31
+	}
+}
+
+// Skew reports how unevenly keys and accesses are spread across the stripes.
+//
+// Parameters:
+//   - threshold: The access skew above which another seed is looked for, e.g. 2 when the busiest
+//     stripe should see at most twice the average load. Values of 1 or less use 2.
+//
+// Returns:
+//   - A SkewReport with the per-stripe key and access counts, their skew, and a suggested seed when
+//     the access skew exceeds the threshold and one of the candidate seeds would lower it.
+//
+// Details:
+//   - Accesses are the read counts of the entries currently cached, plus one per entry, so the report
+//     reflects what the cache holds now rather than keys already evicted.
+//   - Rehashing only helps when several busy keys share a stripe: a single very hot key keeps its
+//     stripe busy under any seed.
+func (s *Striped) Skew(threshold float64) SkewReport {
+	if threshold <= 1 {
+		threshold = defaultSkewThreshold
+	}
+	s.route.RLock()
+	base, seed := s.base, s.seed
+	snapshot := s.snapshot()
+	s.route.RUnlock()
+	report := SkewReport{
+		Keys:     make([]int, len(s.stripes)),
+		Accesses: make([]uint64, len(s.stripes)),
+		Seed:     seed,
+	}
+	for i, entries := range snapshot {
+		report.Keys[i] = len(entries)
+		for _, e := range entries {
+			report.Accesses[i] += e.AccessCount + 1
+		}
+	}
+	report.KeySkew = skew(report.Keys)
+	report.AccessSkew = skew(report.Accesses)
+	report.Skewed = report.AccessSkew > threshold
+	if !report.Skewed {
+		return report
+	}
+	best := report.AccessSkew
+	for candidate := seed + 1; candidate <= seed+defaultSkewCandidates; candidate++ {
+		hasher := seedHasher(base, candidate)
+		loads := make([]uint64, len(s.stripes))
+		for _, entries := range snapshot {
+			for _, e := range entries {
+				loads[hasher(e.Key)&s.mask] += e.AccessCount + 1
+			}
+		}
+		if projected := skew(loads); projected < best {
+			best = projected
+			report.Suggested, report.ProjectedSkew = candidate, projected
+		}
+	}
+	return report
+}
+
+// Reseed mixes a seed into the hasher of the stripes and moves every entry whose stripe changes.
+//
+// Parameters:
+//   - seed: The new seed, e.g. SkewReport.Suggested. Zero restores the hasher set by WithHasher.
+//
+// Returns:
+//   - The number of entries that changed stripe.
+//
+// Details:
+//   - The seed is mixed into the hasher set by WithHasher (DefaultHasher unless configured), so a
+//     custom hasher is kept; with the default one the stripes use SeededHasher(seed).
+//   - Safe to call on a live cache: keyed operations wait while the entries move, and none can reach
+//     a stripe by the old seed afterwards. Stripes obtained from Stripe beforehand may no longer hold
+//     their keys.
+//   - A moved entry keeps its whole state: value, expiration, tags, pin, metadata, access count,
+//     version, persistence, and per-entry callbacks. Nothing is reported or closed, and the receiving
+//     stripe slots it in by last access time.
+//   - A stripe receiving more entries than its capacity evicts the overflow as usual.
+func (s *Striped) Reseed(seed uint64) int {
+	s.route.Lock()
+	defer s.route.Unlock()
+	hasher := seedHasher(s.base, seed)
+	for _, stripe := range s.stripes {
+		stripe.mutex.Lock()
+	}
+	moved := 0
+	arrivals := make([][]*entries, len(s.stripes))
+	for i, stripe := range s.stripes {
+		for element := stripe.list.Front(); element != nil; {
+			next := element.Next()
+			if j := hasher(element.Value.(*entries).key) & s.mask; j != uint64(i) {
+				arrivals[j] = append(arrivals[j], stripe.detach(element))
+				moved++
+			}
+			element = next
+		}
+	}
+	s.hasher, s.seed = hasher, seed
+	for i, stripe := range s.stripes {
+		stripe.adopt(arrivals[i])
+		stripe.mutex.Unlock()
+	}
+	return moved
+}
+
+// Rebalance reseeds the stripes when the access skew exceeds a threshold and another seed lowers it.
+//
+// Parameters:
+//   - threshold: The access skew tolerated; see Skew.
+//
+// Returns:
+//   - The report the decision was based on.
+//   - true if the cache was reseeded with the report's suggested seed.
+//
+// Details:
+//   - Like Reseed, it is safe to call on a live cache, e.g. from a periodic job.
+func (s *Striped) Rebalance(threshold float64) (SkewReport, bool) {
+	report := s.Skew(threshold)
+	if report.Suggested == 0 {
+		return report, false
+	}
+	s.Reseed(report.Suggested)
+	return report, true
+}
+
+// snapshot returns the entries of every stripe, each in recency order.
+func (s *Striped) snapshot() [][]Entry {
+	snapshot := make([][]Entry, len(s.stripes))
+	for i, stripe := range s.stripes {
+		snapshot[i] = stripe.Snapshot()
+	}
+	return snapshot
+}
+
+// detach unlinks an entry so that another stripe can adopt it, leaving the entry itself untouched.
+//
+// Returns:
+//   - The entry, no longer referenced by the cache.
+//
+// Details:
+//   - Must be called with the write lock held.
+//   - Unlike drop, it runs no callback, reports no event, and does not recycle the entry.
+func (c *LRU) detach(element *list.Element) *entries {
+	entry := element.Value.(*entries)
+	if c.sweepCursor == element {
+		c.sweepCursor = nil
+	}
+	c.unintern(entry.value)
+	c.untag(entry)
+	c.unindex(entry)
+	if c.prefixes != nil {
+		c.prefixes.remove(entry.key)
+	}
+	c.unlinkQuotas(element)
+	delete(c.cache, entry.key)
+	c.list.Remove(element)
+	return entry
+}
+
+// adopt links entries detached from other stripes into the cache, then enforces the capacity.
+//
+// Parameters:
+//   - arrivals: The entries to link; their keys must not be in the cache.
+//
+// Details:
+//   - Must be called with the write lock held.
+//   - Each entry is placed before the first entry accessed no later than it, so the list stays in
+//     recency order, and the version counter is raised so its versions are never handed out again.
+func (c *LRU) adopt(arrivals []*entries) {
+	if len(arrivals) == 0 {
+		return
+	}
+	sort.SliceStable(arrivals, func(a, b int) bool {
+		return arrivals[a].accessTime.After(arrivals[b].accessTime)
+	})
+	cursor := c.list.Front()
+	for _, entry := range arrivals {
+		for cursor != nil && cursor.Value.(*entries).accessTime.After(entry.accessTime) {
+			cursor = cursor.Next()
+		}
+		entry.value = c.intern(entry.value)
+		if cursor != nil {
+			c.cache[entry.key] = c.list.InsertBefore(entry, cursor)
+		} else {
+			c.cache[entry.key] = c.list.PushBack(entry)
+		}
+		c.version = max(c.version, entry.version)
+		c.tag(entry)
+		c.reindex(entry, c.view(entry.value))
+		if c.prefixes != nil {
+			c.prefixes.insert(entry.key)
+		}
+	}
+	c.orderQuotas()
+	c.enforceCapacity(nil)
+}
+
+// skew returns the ratio of the largest count to the average count, or 0 if every count is zero.
+func skew[T int | uint64](counts []T) float64 {
+	var total, peak T
+	for _, n := range counts {
+		total += n
+		peak = max(peak, n)
+	}
+	if total == 0 {
+		return 0
+	}
+	return float64(peak) * float64(len(counts)) / float64(total)
+}
@@ -0,0 +1,91 @@
+package redisadapter
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/redis/go-redis/v9"
+)
+
+// Broadcaster is a cachify.Broadcaster backed by Redis pub/sub.
+//
+// Fields:
+//   - client: The go-redis client used to publish and subscribe.
+//   - channel: The pub/sub channel shared by all instances.
+//   - mutex: A lock protecting the active subscriptions.
+//   - subs: The subscriptions opened by Subscribe, closed by Close.
+type Broadcaster struct {
+	client  redis.UniversalClient
+	channel string
+	mutex   sync.Mutex
+	subs    []*redis.PubSub
+}
+
+var _ cachify.Broadcaster = (*Broadcaster)(nil)
+
+// NewBroadcaster creates a new Redis pub/sub broadcaster.
+//
+// Parameters:
+//   - client: A go-redis client.
+//   - channel: The pub/sub channel name, e.g. "cachify:invalidate:users".
+//
+// Returns:
+//   - A pointer to an initialized Broadcaster.
+func NewBroadcaster(client redis.UniversalClient, channel string) *Broadcaster {
+	return &Broadcaster{
+		client:  client,
+		channel: channel,
+	}
+}
+
+// Publish sends an invalidation message to the channel as JSON.
+func (b *Broadcaster) Publish(ctx context.Context, msg cachify.Invalidation) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return b.client.Publish(ctx, b.channel, data).Err()
+}
+
+// Subscribe subscribes to the channel and delivers every decoded message to the handler.
+//
+// Details:
+//   - Waits for the subscription to be confirmed before returning.
+//   - Malformed messages are skipped.
+func (b *Broadcaster) Subscribe(ctx context.Context, handler func(msg cachify.Invalidation)) error {
+	sub := b.client.Subscribe(ctx, b.channel)
+	if _, err := sub.Receive(ctx); err != nil {
+		_ = sub.Close()
+		return err
+	}
+	b.mutex.Lock()
+	b.subs = append(b.subs, sub)
+	b.mutex.Unlock()
+
+	go func() {
+		for m := range sub.Channel() {
+			var msg cachify.Invalidation
+			if err := json.Unmarshal([]byte(m.Payload), &msg); err != nil {
+				continue
+			}
+			handler(msg)
+		}
+	}()
+	return nil
+}
+
+// Close closes every subscription opened by Subscribe.
+func (b *Broadcaster) Close() error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	var err error
+	for _, sub := range b.subs {
+		if e := sub.Close(); e != nil && err == nil {
+			err = e
+		}
+	}
+	b.subs = nil
+	return err
+}
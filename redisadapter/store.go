@@ -0,0 +1,98 @@
+// Package redisadapter provides Redis-backed implementations of the cachify extension points.
+package redisadapter
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/redis/go-redis/v9"
+)
+
+// Store is a cachify.Store backed by Redis.
+//
+// Fields:
+//   - client: The go-redis client used for all commands.
+//   - prefix: A string prepended to every key written to Redis.
+//   - codec: The codec used to serialize values.
+type Store struct {
+	client redis.UniversalClient
+	prefix string
+	codec  cachify.Codec
+}
+
+var _ cachify.Store = (*Store)(nil)
+
+// NewStore creates a new Redis-backed store.
+//
+// Parameters:
+//   - client: A go-redis client (single node, cluster, or sentinel).
+//
+// Returns:
+//   - A pointer to an initialized Store using JSON encoding and no key prefix.
+func NewStore(client redis.UniversalClient) *Store {
+	return &Store{
+		client: client,
+		codec:  cachify.JSONCodec{},
+	}
+}
+
+// WithPrefix sets a prefix prepended to every key, e.g. "app:cache:".
+//
+// Parameters:
+//   - prefix: The key prefix.
+//
+// Returns:
+//   - The Store, for chaining.
+func (s *Store) WithPrefix(prefix string) *Store {
+	s.prefix = prefix
+	return s
+}
+
+// WithCodec sets the codec used to serialize values.
+//
+// Parameters:
+//   - codec: The codec to use.
+//
+// Returns:
+//   - The Store, for chaining.
+func (s *Store) WithCodec(codec cachify.Codec) *Store {
+	s.codec = codec
+	return s
+}
+
+// Get retrieves and decodes the value stored for a key.
+//
+// Returns:
+//   - The decoded value, a boolean indicating whether the key exists, and any Redis or decode error.
+func (s *Store) Get(ctx context.Context, key string) (value interface{}, ok bool, err error) {
+	data, err := s.client.Get(ctx, s.prefix+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	if err := s.codec.Unmarshal(data, &value); err != nil {
+		return nil, false, err
+	}
+	return value, true, nil
+}
+
+// Set encodes and stores a value for a key.
+//
+// Details:
+//   - The ttl is propagated to Redis as the key expiration. Zero means the key does not expire.
+func (s *Store) Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	data, err := s.codec.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return s.client.Set(ctx, s.prefix+key, data, ttl).Err()
+}
+
+// Delete removes a key from Redis.
+func (s *Store) Delete(ctx context.Context, key string) error {
+	return s.client.Del(ctx, s.prefix+key).Err()
+}
@@ -0,0 +1,41 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/pnguyen215/cachify/redisadapter"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test invalidations published over Redis drop the key on peers but not on the sender
+func TestRedisBroadcaster(t *testing.T) {
+	server, client := newRedis(t)
+	a, err := cachify.NewInvalidator(cachify.NewLRU(10), redisadapter.NewBroadcaster(client, "invalidate"))
+	assert.NoError(t, err)
+	defer a.Close()
+	b, err := cachify.NewInvalidator(cachify.NewLRU(10), redisadapter.NewBroadcaster(client, "invalidate"))
+	assert.NoError(t, err)
+
+	b.Cache().Set("k", "stale")
+	a.Set("k", "fresh")
+	assert.Eventually(t, func() bool { return !b.Cache().Contains("k") }, time.Second, time.Millisecond)
+	value, ok := a.Get("k")
+	assert.True(t, ok)
+	assert.Equal(t, "fresh", value)
+
+	// Malformed payloads are skipped without stopping delivery
+	server.Publish("invalidate", "not json")
+	b.Cache().Set("k", "stale")
+	a.Invalidate("k")
+	assert.Eventually(t, func() bool { return !b.Cache().Contains("k") }, time.Second, time.Millisecond)
+
+	// A closed broadcaster stops delivering
+	assert.NoError(t, b.Close())
+	b.Cache().Set("k", "kept")
+	assert.NoError(t, a.InvalidateContext(context.Background(), "k"))
+	time.Sleep(20 * time.Millisecond)
+	assert.True(t, b.Cache().Contains("k"))
+}
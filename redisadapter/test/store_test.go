@@ -0,0 +1,69 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/pnguyen215/cachify"
+	"github.com/pnguyen215/cachify/redisadapter"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+// newRedis starts an in-process Redis server and returns a client connected to it.
+func newRedis(t *testing.T) (*miniredis.Miniredis, *redis.Client) {
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { client.Close() })
+	return server, client
+}
+
+// Test the Redis store prefixes keys and propagates TTLs
+func TestRedisStore(t *testing.T) {
+	server, client := newRedis(t)
+	store := redisadapter.NewStore(client).WithPrefix("app:")
+	ctx := context.Background()
+
+	assert.NoError(t, store.Set(ctx, "a", "alpha", time.Minute))
+	assert.NoError(t, store.Set(ctx, "b", "beta", 0))
+	assert.True(t, server.Exists("app:a"))
+	assert.False(t, server.Exists("a"))
+	assert.Equal(t, time.Minute, server.TTL("app:a"))
+	assert.Zero(t, server.TTL("app:b"))
+
+	value, ok, err := store.Get(ctx, "a")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "alpha", value)
+
+	server.FastForward(2 * time.Minute)
+	_, ok, err = store.Get(ctx, "a")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	_, ok, _ = store.Get(ctx, "b")
+	assert.True(t, ok)
+
+	assert.NoError(t, store.Delete(ctx, "b"))
+	assert.False(t, server.Exists("app:b"))
+	assert.NoError(t, store.Delete(ctx, "missing"))
+}
+
+// Test the Redis store decodes with its codec and reports undecodable values
+func TestRedisStore_Codec(t *testing.T) {
+	server, client := newRedis(t)
+	store := redisadapter.NewStore(client).WithCodec(cachify.GobCodec{})
+	ctx := context.Background()
+
+	assert.NoError(t, store.Set(ctx, "n", 42, 0))
+	value, ok, err := store.Get(ctx, "n")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 42, value)
+
+	assert.NoError(t, server.Set("bad", "not gob"))
+	_, ok, err = store.Get(ctx, "bad")
+	assert.Error(t, err)
+	assert.False(t, ok)
+}
@@ -0,0 +1,87 @@
+package cachify
+
+import (
+	"sort"
+	"sync"
+)
+
+// caches holds the caches registered by name, so an admin endpoint can report on every cache in the process.
+var caches = struct {
+	sync.RWMutex
+	byName map[string]Cache
+}{byName: map[string]Cache{}}
+
+// Register makes a cache available by name.
+//
+// Parameters:
+//   - name: The cache name, e.g. "users". Registering an existing name replaces it.
+//   - cache: The cache. Nil removes the name, like Unregister.
+func Register(name string, cache Cache) {
+	caches.Lock()
+	defer caches.Unlock()
+	if cache == nil {
+		delete(caches.byName, name)
+		return
+	}
+	caches.byName[name] = cache
+}
+
+// Unregister removes a cache from the registry, typically when it is closed.
+func Unregister(name string) {
+	caches.Lock()
+	defer caches.Unlock()
+	delete(caches.byName, name)
+}
+
+// LookupCache returns the cache registered under a name.
+//
+// Returns:
+//   - The cache and a boolean indicating whether it was found.
+func LookupCache(name string) (Cache, bool) {
+	caches.RLock()
+	defer caches.RUnlock()
+	cache, ok := caches.byName[name]
+	return cache, ok
+}
+
+// RegisteredNames returns the names of every registered cache in lexical order.
+func RegisteredNames() []string {
+	caches.RLock()
+	defer caches.RUnlock()
+	names := make([]string, 0, len(caches.byName))
+	for name := range caches.byName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// RegisteredStats returns the stats of every registered cache, keyed by name.
+//
+// Details:
+//   - Stats are collected after releasing the registry lock, so a slow cache does not block registration.
+func RegisteredStats() map[string]Stats {
+	caches.RLock()
+	registered := make(map[string]Cache, len(caches.byName))
+	for name, cache := range caches.byName {
+		registered[name] = cache
+	}
+	caches.RUnlock()
+
+	stats := make(map[string]Stats, len(registered))
+	for name, cache := range registered {
+		stats[name] = cache.Stats()
+	}
+	return stats
+}
+
+// ResetRegisteredStats resets the counters of every registered cache that supports ResetStats.
+func ResetRegisteredStats() {
+	caches.RLock()
+	defer caches.RUnlock()
+	for _, cache := range caches.byName {
+		if r, ok := cache.(interface{ ResetStats() }); ok {
+			r.ResetStats()
+		}
+	}
+}
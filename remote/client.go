@@ -0,0 +1,190 @@
+package remote
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pnguyen215/cachify"
+)
+
+// Client is a cachify.Store talking to a remote Server, so a Tiered cache can use a cachify node as its L2.
+//
+// Fields:
+//   - baseURL: The server URL, without a trailing slash.
+//   - client: The HTTP client used for every request.
+//   - codec: The codec used to serialize values.
+//   - keysPath: The path under which keys are addressed, "/v1/keys/" or "/v1/fill/" for peers.
+type Client struct {
+	baseURL  string
+	client   *http.Client
+	codec    cachify.Codec
+	keysPath string
+}
+
+var _ cachify.Store = (*Client)(nil)
+
+// NewClient creates a client for a remote server.
+//
+// Parameters:
+//   - baseURL: The server URL, e.g. "http://cache:7070".
+//
+// Returns:
+//   - A pointer to an initialized Client using JSON encoding and http.DefaultClient.
+func NewClient(baseURL string) *Client {
+	return &Client{
+		baseURL:  strings.TrimRight(baseURL, "/"),
+		client:   http.DefaultClient,
+		codec:    cachify.JSONCodec{},
+		keysPath: keysPath,
+	}
+}
+
+// NewPeer creates a client fetching keys from a peer's FillHandler, for use on the owners ring of
+// cachify.Loading.WithOwners.
+//
+// Parameters:
+//   - baseURL: The peer URL, e.g. "http://10.0.0.2:7070".
+//
+// Returns:
+//   - A pointer to an initialized Client using JSON encoding. Only Get is meaningful: the owner
+//     loads missing keys itself, so Set and Delete are rejected by the peer.
+func NewPeer(baseURL string) *Client {
+	c := NewClient(baseURL)
+	c.keysPath = fillPath
+	return c
+}
+
+// WithHTTPClient sets the HTTP client, e.g. to configure timeouts or transport pooling.
+//
+// Returns:
+//   - The Client, for chaining.
+func (c *Client) WithHTTPClient(client *http.Client) *Client {
+	c.client = client
+	return c
+}
+
+// WithCodec sets the codec used to serialize values, e.g. cachify.GobCodec{} to preserve Go types.
+// Every client sharing keys must use the same codec.
+//
+// Returns:
+//   - The Client, for chaining.
+func (c *Client) WithCodec(codec cachify.Codec) *Client {
+	c.codec = codec
+	return c
+}
+
+// Get retrieves and decodes the value stored for a key.
+func (c *Client) Get(ctx context.Context, key string) (value interface{}, ok bool, err error) {
+	response, err := c.do(ctx, http.MethodGet, c.keyURL(key), nil)
+	if err != nil {
+		return nil, false, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode == http.StatusNotFound {
+		return nil, false, nil
+	}
+	if err := checkStatus(response, http.StatusOK); err != nil {
+		return nil, false, err
+	}
+	data, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, false, err
+	}
+	if err := c.codec.Unmarshal(data, &value); err != nil {
+		return nil, false, err
+	}
+	return value, true, nil
+}
+
+// Set encodes and stores a value for a key.
+//
+// Details:
+//   - Zero ttl means the value does not expire, although the server's own expiry may still apply.
+func (c *Client) Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	data, err := c.codec.Marshal(value)
+	if err != nil {
+		return err
+	}
+	target := c.keyURL(key)
+	if ttl > 0 {
+		target += "?ttl=" + url.QueryEscape(ttl.String())
+	}
+	response, err := c.do(ctx, http.MethodPut, target, data)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	return checkStatus(response, http.StatusNoContent)
+}
+
+// Delete removes a key from the server.
+func (c *Client) Delete(ctx context.Context, key string) error {
+	response, err := c.do(ctx, http.MethodDelete, c.keyURL(key), nil)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	return checkStatus(response, http.StatusNoContent)
+}
+
+// Stats retrieves the usage counters of the remote cache.
+func (c *Client) Stats(ctx context.Context) (cachify.Stats, error) {
+	var stats cachify.Stats
+	response, err := c.do(ctx, http.MethodGet, c.baseURL+"/v1/stats", nil)
+	if err != nil {
+		return stats, err
+	}
+	defer response.Body.Close()
+	if err := checkStatus(response, http.StatusOK); err != nil {
+		return stats, err
+	}
+	err = json.NewDecoder(response.Body).Decode(&stats)
+	return stats, err
+}
+
+// keyURL returns the URL of a key.
+func (c *Client) keyURL(key string) string {
+	return c.baseURL + c.keysPath + url.PathEscape(key)
+}
+
+// do sends a request with an optional body.
+func (c *Client) do(ctx context.Context, method, target string, body []byte) (*http.Response, error) {
+	request, err := http.NewRequestWithContext(ctx, method, target, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	return c.client.Do(request)
+}
+
+// checkStatus returns an error carrying the server's message unless the response has the expected status.
+func checkStatus(response *http.Response, expected int) error {
+	if response.StatusCode == expected {
+		return nil
+	}
+	message, _ := io.ReadAll(io.LimitReader(response.Body, 512))
+	return fmt.Errorf("remote: %s %s: %s: %s", response.Request.Method, response.Request.URL.Path,
+		response.Status, strings.TrimSpace(string(message)))
+}
+
+// NewCluster creates a consistent-hash ring with one Client per server, forming a simple cache cluster.
+//
+// Parameters:
+//   - replicas: The number of virtual points per server. Zero or less uses the StoreRing default.
+//   - baseURLs: The server URLs; each URL is also its node name.
+//
+// Returns:
+//   - A StoreRing routing each key to one server. Add or Remove nodes to resize the cluster.
+func NewCluster(replicas int, baseURLs ...string) *cachify.StoreRing {
+	ring := cachify.NewStoreRing(replicas)
+	for _, baseURL := range baseURLs {
+		ring.Add(baseURL, NewClient(baseURL))
+	}
+	return ring
+}
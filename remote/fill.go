@@ -0,0 +1,84 @@
+package remote
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/pnguyen215/cachify"
+)
+
+const (
+	// keysPath is the path under which the Server addresses keys.
+	keysPath = "/v1/keys/"
+
+	// fillPath is the path under which the FillHandler addresses keys.
+	fillPath = "/v1/fill/"
+)
+
+// FillHandler lets peers fetch the keys this instance owns, loading them on a miss.
+//
+// Fields:
+//   - loading: The loading cache filling the keys.
+//   - codec: The codec used to serialize values.
+//   - mux: The router dispatching the endpoint.
+type FillHandler struct {
+	loading *cachify.Loading
+	codec   cachify.Codec
+	mux     *http.ServeMux
+}
+
+// NewFillHandler creates a handler serving GET /v1/fill/{key} from a loading cache.
+//
+// Parameters:
+//   - loading: The loading cache, configured with cachify.Loading.WithOwners.
+//
+// Returns:
+//   - A pointer to an initialized FillHandler using JSON encoding.
+//
+// Details:
+//   - Keys are filled with Loading.Fill, so a request from a peer is never forwarded to another peer.
+//   - Absent keys (cachify.ErrNotFound) are reported with status 404, so the peer does not load them either.
+//   - Other Loader errors are reported with status 502, so the peer falls back to loading the key itself.
+func NewFillHandler(loading *cachify.Loading) *FillHandler {
+	h := &FillHandler{
+		loading: loading,
+		codec:   cachify.JSONCodec{},
+		mux:     http.NewServeMux(),
+	}
+	h.mux.HandleFunc("GET "+fillPath+"{key...}", h.fill)
+	return h
+}
+
+// WithCodec sets the codec used to serialize values; it must match the peers' clients.
+//
+// Returns:
+//   - The FillHandler, for chaining.
+func (h *FillHandler) WithCodec(codec cachify.Codec) *FillHandler {
+	h.codec = codec
+	return h
+}
+
+// ServeHTTP dispatches a request to the fill endpoint.
+func (h *FillHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+// fill loads and writes the value of a key.
+func (h *FillHandler) fill(w http.ResponseWriter, r *http.Request) {
+	value, err := h.loading.Fill(r.Context(), r.PathValue("key"))
+	if errors.Is(err, cachify.ErrNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	data, err := h.codec.Marshal(value)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	_, _ = w.Write(data)
+}
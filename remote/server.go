@@ -0,0 +1,146 @@
+// Package remote serves a cachify cache over HTTP and provides the matching client, so small deployments
+// can share a cache between processes without running Redis.
+//
+// The protocol is plain REST; values are opaque bytes encoded by the client's cachify.Codec:
+//   - GET /v1/keys/{key}: The value of a key, or 404 if it is missing or expired.
+//   - PUT /v1/keys/{key}?ttl=30s: Stores the request body; ttl is optional and uses time.ParseDuration syntax.
+//   - DELETE /v1/keys/{key}: Removes a key; deleting a missing key succeeds.
+//   - GET /v1/stats: The cache stats as JSON.
+//   - GET /v1/fill/{key}: Served by FillHandler; the value of a key owned by this instance, loaded on a miss.
+package remote
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/pnguyen215/cachify"
+)
+
+// defaultMaxValueSize is the largest request body accepted by PUT unless overridden with WithMaxValueSize.
+const defaultMaxValueSize = 32 << 20
+
+// Server exposes a cachify LRU cache over HTTP.
+//
+// Fields:
+//   - cache: The cache holding the values.
+//   - mux: The router dispatching the endpoints.
+//   - maxValueSize: The largest value accepted, in bytes.
+type Server struct {
+	cache        *cachify.LRU
+	mux          *http.ServeMux
+	maxValueSize int64
+}
+
+// item is a value stored by the server.
+//
+// Fields:
+//   - data: The encoded value, as sent by the client.
+//   - deadline: When the value expires, or the zero time if it does not.
+type item struct {
+	data     []byte
+	deadline time.Time
+}
+
+// NewServer creates a server over an existing cache.
+//
+// Parameters:
+//   - cache: The cache holding the values. Its capacity and default expiry apply on top of per-key TTLs.
+//
+// Returns:
+//   - A pointer to an initialized Server accepting values up to 32 MiB.
+func NewServer(cache *cachify.LRU) *Server {
+	s := &Server{
+		cache:        cache,
+		mux:          http.NewServeMux(),
+		maxValueSize: defaultMaxValueSize,
+	}
+	s.mux.HandleFunc("GET "+keysPath+"{key...}", s.get)
+	s.mux.HandleFunc("PUT "+keysPath+"{key...}", s.set)
+	s.mux.HandleFunc("DELETE "+keysPath+"{key...}", s.delete)
+	s.mux.HandleFunc("GET /v1/stats", s.stats)
+	return s
+}
+
+// WithMaxValueSize sets the largest value accepted by PUT; larger bodies are rejected with 413.
+//
+// Returns:
+//   - The Server, for chaining.
+func (s *Server) WithMaxValueSize(size int64) *Server {
+	s.maxValueSize = size
+	return s
+}
+
+// Cache returns the cache served.
+func (s *Server) Cache() *cachify.LRU {
+	return s.cache
+}
+
+// ServeHTTP dispatches a request to the matching endpoint.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// get writes the value of a key.
+func (s *Server) get(w http.ResponseWriter, r *http.Request) {
+	key := r.PathValue("key")
+	value, ok := s.cache.Get(key)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	it, ok := value.(*item)
+	if !ok {
+		http.Error(w, "value was not stored through the remote protocol", http.StatusInternalServerError)
+		return
+	}
+	if !it.deadline.IsZero() && !time.Now().Before(it.deadline) {
+		s.cache.Remove(key)
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	_, _ = w.Write(it.data)
+}
+
+// set stores the request body under a key.
+func (s *Server) set(w http.ResponseWriter, r *http.Request) {
+	it := &item{}
+	if raw := r.URL.Query().Get("ttl"); raw != "" {
+		ttl, err := time.ParseDuration(raw)
+		if err != nil {
+			http.Error(w, "invalid ttl: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		if ttl > 0 {
+			it.deadline = time.Now().Add(ttl)
+		}
+	}
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, s.maxValueSize))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	it.data = data
+	s.cache.Set(r.PathValue("key"), it)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// delete removes a key.
+func (s *Server) delete(w http.ResponseWriter, r *http.Request) {
+	s.cache.Remove(r.PathValue("key"))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// stats writes the cache stats as JSON.
+func (s *Server) stats(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(s.cache.Stats())
+}
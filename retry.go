@@ -0,0 +1,103 @@
+package cachify
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+)
+
+// WithRetry sets the retry policy for Loader calls.
+//
+// Parameters:
+//   - attempts: The total number of tries per load. Values below 1 are treated as 1, which disables retries.
+//   - backoff: The initial delay between tries, doubled after each failure.
+//
+// Returns:
+//   - The Loading cache, for chaining.
+//
+// Details:
+//   - Only errors deemed retryable are retried (see WithRetryable); others are returned immediately.
+//   - Waiting between tries stops as soon as the context is done.
+//   - Concurrent callers of the same key share the whole sequence of tries.
+func (l *Loading) WithRetry(attempts int, backoff time.Duration) *Loading {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.attempts = max(attempts, 1)
+	l.backoff = max(backoff, 0)
+	return l
+}
+
+// WithRetryJitter randomizes the delay between Loader tries so that callers do not retry in lockstep.
+//
+// Parameters:
+//   - jitter: The fraction of each delay that is randomized, between 0 and 1. A jitter of 0.5 waits
+//     between 50% and 150% of the delay. Zero disables it.
+//
+// Returns:
+//   - The Loading cache, for chaining.
+func (l *Loading) WithRetryJitter(jitter float64) *Loading {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.jitter = min(max(jitter, 0), 1)
+	return l
+}
+
+// WithRetryable sets the function deciding which Loader errors are worth retrying.
+//
+// Parameters:
+//   - retryable: A function reporting whether an error is transient. Nil restores the default, which
+//     retries every error except ErrNotFound, context cancellations and deadlines, and panics.
+//
+// Returns:
+//   - The Loading cache, for chaining.
+func (l *Loading) WithRetryable(retryable func(err error) bool) *Loading {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.retryable = retryable
+	return l
+}
+
+// callLoader invokes the Loader for a key, retrying transient failures according to the retry policy.
+func (l *Loading) callLoader(ctx context.Context, key string) (interface{}, error) {
+	l.mutex.Lock()
+	attempts, backoff, jitter, retryable := l.attempts, l.backoff, l.jitter, l.retryable
+	l.mutex.Unlock()
+	attempts = max(attempts, 1)
+	if retryable == nil {
+		retryable = isRetryable
+	}
+	l.cache.mutex.RLock()
+	onPanic := l.cache.onPanic
+	l.cache.mutex.RUnlock()
+	var value interface{}
+	var err error
+	for i := 0; i < attempts; i++ {
+		if panicErr := invoke(&l.cache.stats, onPanic, "load", key, func() { value, err = l.loader(ctx, key) }); panicErr != nil {
+			value, err = nil, panicErr
+		}
+		if err == nil || i == attempts-1 || !retryable(err) {
+			break
+		}
+		delay := backoff
+		if jitter > 0 {
+			delay += time.Duration(float64(delay) * jitter * (2*rand.Float64() - 1))
+		}
+		select {
+		case <-time.After(delay):
+			backoff *= 2
+		case <-ctx.Done():
+			return nil, errors.Join(err, ctx.Err())
+		}
+	}
+	return value, err
+}
+
+// isRetryable is the default classification of Loader errors: everything is retried except missing
+// keys, context cancellations and deadlines, and panics.
+func isRetryable(err error) bool {
+	return !errors.Is(err, ErrNotFound) &&
+		!errors.Is(err, context.Canceled) &&
+		!errors.Is(err, context.DeadlineExceeded) &&
+		!errors.Is(err, ErrPanic)
+}
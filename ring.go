@@ -0,0 +1,176 @@
+package cachify
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+)
+
+var _ Store = (*StoreRing)(nil)
+
+// NewStoreRing creates an empty consistent-hash ring of Stores.
+//
+// Parameters:
+//   - replicas: The number of virtual points per node; more points spread keys more evenly. Zero or less uses 160.
+//
+// Returns:
+//   - A pointer to an initialized StoreRing using DefaultHasher. Add nodes with Add.
+func NewStoreRing(replicas int) *StoreRing {
+	if replicas <= 0 {
+		replicas = defaultRingReplicas
+	}
+	return &StoreRing{
+		replicas: replicas,
+		hasher:   DefaultHasher,
+		owners:   make(map[uint64]string),
+		nodes:    make(map[string]Store),
+	}
+}
+
+// WithHasher sets the hash function placing keys and nodes on the ring.
+//
+// Parameters:
+//   - hasher: The hash function. Nil restores DefaultHasher.
+//
+// Returns:
+//   - The StoreRing, for chaining.
+//
+// Details:
+//   - Every client of a cluster must use the same hasher, replicas, and node names to agree on key owners.
+func (r *StoreRing) WithHasher(hasher Hasher) *StoreRing {
+	if hasher == nil {
+		hasher = DefaultHasher
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.hasher = hasher
+	r.rebuild()
+	return r
+}
+
+// Add places a node on the ring, or replaces the Store of an existing node.
+//
+// Parameters:
+//   - name: The node name, e.g. its address. Placement depends only on the name.
+//   - store: The Store serving the node's keys.
+//
+// Details:
+//   - Only the keys now owned by the new node move; they start as misses on it.
+func (r *StoreRing) Add(name string, store Store) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.nodes[name] = store
+	r.rebuild()
+}
+
+// Remove takes a node off the ring.
+//
+// Details:
+//   - The node's keys are redistributed over the remaining nodes, where they start as misses.
+func (r *StoreRing) Remove(name string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if _, exists := r.nodes[name]; !exists {
+		return
+	}
+	delete(r.nodes, name)
+	r.rebuild()
+}
+
+// Nodes returns the node names in lexical order.
+func (r *StoreRing) Nodes() []string {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	names := make([]string, 0, len(r.nodes))
+	for name := range r.nodes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Pick returns the node owning a key.
+//
+// Returns:
+//   - The node name and its Store.
+//   - A boolean indicating whether the ring has any node.
+func (r *StoreRing) Pick(key string) (name string, store Store, ok bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	if len(r.points) == 0 {
+		return "", nil, false
+	}
+	hash := r.hasher(key)
+	i := sort.Search(len(r.points), func(i int) bool { return r.points[i] >= hash })
+	if i == len(r.points) {
+		i = 0
+	}
+	name = r.owners[r.points[i]]
+	return name, r.nodes[name], true
+}
+
+// Get retrieves the value of a key from the node owning it.
+//
+// Returns:
+//   - ErrNoNodes if the ring is empty, otherwise the result of the owning Store.
+func (r *StoreRing) Get(ctx context.Context, key string) (value interface{}, ok bool, err error) {
+	_, store, found := r.Pick(key)
+	if !found {
+		return nil, false, ErrNoNodes
+	}
+	return store.Get(ctx, key)
+}
+
+// Set stores a value on the node owning the key.
+//
+// Returns:
+//   - ErrNoNodes if the ring is empty, otherwise the result of the owning Store.
+func (r *StoreRing) Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	_, store, found := r.Pick(key)
+	if !found {
+		return ErrNoNodes
+	}
+	return store.Set(ctx, key, value, ttl)
+}
+
+// Delete removes a key from the node owning it.
+//
+// Returns:
+//   - ErrNoNodes if the ring is empty, otherwise the result of the owning Store.
+func (r *StoreRing) Delete(ctx context.Context, key string) error {
+	_, store, found := r.Pick(key)
+	if !found {
+		return ErrNoNodes
+	}
+	return store.Delete(ctx, key)
+}
+
+// rebuild recomputes the ring points from the nodes.
+//
+// Details:
+//   - Must be called with the write lock held.
+//   - Points colliding with an existing one are skipped; ties are resolved by node name so every
+//     client builds the same ring regardless of insertion order.
+func (r *StoreRing) rebuild() {
+	names := make([]string, 0, len(r.nodes))
+	for name := range r.nodes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	points := make([]uint64, 0, len(names)*r.replicas)
+	owners := make(map[uint64]string, len(names)*r.replicas)
+	for _, name := range names {
+		for i := 0; i < r.replicas; i++ {
+			point := r.hasher(name + "-" + strconv.Itoa(i))
+			if _, exists := owners[point]; exists {
+				continue
+			}
+			owners[point] = name
+			points = append(points, point)
+		}
+	}
+	sort.Slice(points, func(i, j int) bool { return points[i] < points[j] })
+	r.points = points
+	r.owners = owners
+}
@@ -0,0 +1,61 @@
+package cachify
+
+import "fmt"
+
+// WithSerialization stores values encoded with the cache's codec instead of by reference.
+//
+// Parameters:
+//   - enabled: Whether values written from now on are serialized.
+//
+// Returns:
+//   - The LRU cache, for chaining.
+//
+// Details:
+//   - Every read decodes a fresh value, so callers can never mutate what is cached or what
+//     other readers see, and the maximum value size is checked against the exact encoded length.
+//   - Encoding on every write and decoding on every read costs CPU; a Copier is cheaper when only
+//     isolation is needed.
+//   - Values come back as the codec decodes them into an interface{}: GobCodec restores registered
+//     concrete types, while JSONCodec returns maps, slices, float64, and strings.
+//   - A value that cannot be encoded is rejected like a value that is too large; SetE reports the error.
+//   - Serialized values are also compressed when a compressor is configured.
+//   - Entries written before the mode changed keep their form and stay readable.
+func (c *LRU) WithSerialization(enabled bool) *LRU {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.serialize = enabled
+	return c
+}
+
+// IsSerialized checks whether values are stored encoded with the codec.
+func (c *LRU) IsSerialized() bool {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.serialize
+}
+
+// encode converts a value to its serialized stored form.
+//
+// Details:
+//   - Must be called with the lock held.
+func (c *LRU) encode(value interface{}) (interface{}, error) {
+	codec := c.codec
+	if codec == nil {
+		codec = GobCodec{}
+	}
+	data, err := codec.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("cachify: encode: %w", err)
+	}
+	return encoded{data: c.compress(data), size: len(data), codec: codec}, nil
+}
+
+// decode returns a fresh copy of a serialized value, or nil if it cannot be decoded.
+func (e encoded) decode() interface{} {
+	data, _ := decompress(e.data).([]byte)
+	var value interface{}
+	if err := e.codec.Unmarshal(data, &value); err != nil {
+		return nil
+	}
+	return value
+}
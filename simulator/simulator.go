@@ -0,0 +1,144 @@
+// Package simulator replays access traces against cachify caches to estimate hit ratios offline,
+// which helps choosing a capacity and an eviction policy before deploying.
+package simulator
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pnguyen215/cachify"
+)
+
+// Cache is the subset of cache behavior exercised by a replay.
+//
+// Methods:
+//   - Get: Looks a key up, recording an access.
+//   - Set: Inserts a key after a miss.
+type Cache interface {
+	Get(key string) (value interface{}, ok bool)
+	Set(key string, value interface{})
+}
+
+// Policy describes an eviction policy under test.
+//
+// Fields:
+//   - Name: A short name used in reports and to look the policy up.
+//   - New: Creates an empty cache of the given capacity using the real implementation.
+type Policy struct {
+	Name string
+	New  func(capacity int) Cache
+}
+
+// Result reports the outcome of replaying a trace against one policy and capacity.
+//
+// Fields:
+//   - Policy: The name of the policy.
+//   - Capacity: The cache capacity.
+//   - Requests: The number of accesses replayed.
+//   - Hits: The number of accesses served from the cache.
+type Result struct {
+	Policy   string
+	Capacity int
+	Requests int
+	Hits     int
+}
+
+var (
+	// LRU is the default least-recently-used policy.
+	LRU = Policy{Name: "lru", New: func(capacity int) Cache { return cachify.NewLRU(capacity) }}
+
+	// MRU evicts the most recently used entry.
+	MRU = Policy{Name: "mru", New: func(capacity int) Cache {
+		return cachify.NewLRU(capacity).WithEvictionPolicy(cachify.EvictMRU)
+	}}
+
+	// Clock is the CLOCK second-chance approximation of LRU.
+	Clock = Policy{Name: "clock", New: func(capacity int) Cache { return cachify.NewClock(capacity) }}
+
+	// LRU2 is LRU-K with K = 2.
+	LRU2 = Policy{Name: "lru2", New: func(capacity int) Cache { return cachify.NewLRUK(capacity, 2) }}
+)
+
+// Policies returns every built-in policy.
+func Policies() []Policy {
+	return []Policy{LRU, MRU, Clock, LRU2}
+}
+
+// Lookup returns the built-in policy with the given name.
+//
+// Parameters:
+//   - name: The policy name, case-insensitive.
+//
+// Returns:
+//   - The policy and a boolean indicating whether it was found.
+func Lookup(name string) (Policy, bool) {
+	for _, policy := range Policies() {
+		if strings.EqualFold(policy.Name, name) {
+			return policy, true
+		}
+	}
+	return Policy{}, false
+}
+
+// Replay streams a trace once against every combination of policy and capacity.
+//
+// Parameters:
+//   - r: The trace, one key per whitespace-separated token; lines starting with '#' are ignored.
+//   - capacities: The capacities to simulate.
+//   - policies: The policies to simulate. Empty means every built-in policy.
+//
+// Returns:
+//   - One Result per policy and capacity, in argument order.
+//   - An error if the trace cannot be read.
+//
+// Details:
+//   - Each access is a Get followed by a Set on a miss, the usual cache-aside pattern.
+func Replay(r io.Reader, capacities []int, policies ...Policy) ([]Result, error) {
+	if len(policies) == 0 {
+		policies = Policies()
+	}
+	results := make([]Result, 0, len(policies)*len(capacities))
+	caches := make([]Cache, 0, cap(results))
+	for _, policy := range policies {
+		for _, capacity := range capacities {
+			results = append(results, Result{Policy: policy.Name, Capacity: capacity})
+			caches = append(caches, policy.New(capacity))
+		}
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		for _, key := range strings.Fields(line) {
+			for i, cache := range caches {
+				results[i].Requests++
+				if _, ok := cache.Get(key); ok {
+					results[i].Hits++
+					continue
+				}
+				cache.Set(key, struct{}{})
+			}
+		}
+	}
+	return results, scanner.Err()
+}
+
+// HitRatio returns the fraction of accesses served from the cache.
+func (r Result) HitRatio() float64 {
+	if r.Requests == 0 {
+		return 0
+	}
+	return float64(r.Hits) / float64(r.Requests)
+}
+
+// String formats the result as a single report line.
+func (r Result) String() string {
+	return fmt.Sprintf("%-6s capacity=%-8d requests=%-10d hits=%-10d ratio=%.4f",
+		r.Policy, r.Capacity, r.Requests, r.Hits, r.HitRatio())
+}
@@ -0,0 +1,136 @@
+package cachify
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// maxSizeDepth bounds how deep DefaultSizer follows pointers and containers, guarding against cycles.
+const maxSizeDepth = 8
+
+// WithSizer sets the function used to measure values.
+//
+// Parameters:
+//   - sizer: The function to use. Nil restores DefaultSizer.
+//
+// Returns:
+//   - The LRU cache, for chaining.
+func (c *LRU) WithSizer(sizer Sizer) *LRU {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.sizer = sizer
+	return c
+}
+
+// WithMaxValueSize sets the largest value size accepted by the cache.
+//
+// Parameters:
+//   - bytes: The maximum size as measured by the sizer (see WithSizer). Zero or less means unlimited.
+//
+// Returns:
+//   - The LRU cache, for chaining.
+//
+// Details:
+//   - Oversized values bypass the cache instead of evicting other entries to make room: they are not stored,
+//     and any previous value of the key is removed so it is not served stale.
+//   - SetE and UpdateE report the rejection with a *ValueSizeError matching ErrTooLarge; the other writes drop it silently.
+func (c *LRU) WithMaxValueSize(bytes int) *LRU {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.maxValueSize = bytes
+	return c
+}
+
+// DefaultSizer estimates the size of a value in bytes.
+//
+// Parameters:
+//   - value: The value to measure.
+//
+// Returns:
+//   - The length of strings and byte slices, the in-memory size of fixed-size values,
+//     and the sum of the elements for slices, arrays, maps, structs, and pointers.
+func DefaultSizer(value interface{}) int {
+	switch v := value.(type) {
+	case nil:
+		return 0
+	case string:
+		return len(v)
+	case []byte:
+		return len(v)
+	}
+	return sizeOf(reflect.ValueOf(value), 0)
+}
+
+// Error describes the rejected value.
+func (e *ValueSizeError) Error() string {
+	return fmt.Sprintf("cachify: value of %q is %d bytes, exceeding the %d byte limit", e.Key, e.Size, e.Max)
+}
+
+// Unwrap returns ErrTooLarge, so the error matches it with errors.Is.
+func (e *ValueSizeError) Unwrap() error {
+	return ErrTooLarge
+}
+
+// admit checks a value against the maximum value size.
+// Serialized values are measured by the length of their encoding, other values with the sizer.
+// It must be called with the lock held.
+func (c *LRU) admit(key string, value interface{}, stored interface{}) error {
+	if c.maxValueSize <= 0 {
+		return nil
+	}
+	var size int
+	if e, ok := stored.(encoded); ok {
+		size = e.size
+	} else if c.sizer != nil {
+		size = c.sizer(value)
+	} else {
+		size = DefaultSizer(value)
+	}
+	if size <= c.maxValueSize {
+		return nil
+	}
+	return &ValueSizeError{Key: key, Size: size, Max: c.maxValueSize}
+}
+
+// sizeOf recursively estimates the size of a reflected value.
+func sizeOf(v reflect.Value, depth int) int {
+	if !v.IsValid() {
+		return 0
+	}
+	if depth > maxSizeDepth {
+		return int(v.Type().Size())
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return v.Len()
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return int(v.Type().Size())
+		}
+		return int(v.Type().Size()) + sizeOf(v.Elem(), depth+1)
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8 {
+			return v.Len()
+		}
+		size := 0
+		for i := 0; i < v.Len(); i++ {
+			size += sizeOf(v.Index(i), depth+1)
+		}
+		return size
+	case reflect.Map:
+		size := 0
+		iter := v.MapRange()
+		for iter.Next() {
+			size += sizeOf(iter.Key(), depth+1) + sizeOf(iter.Value(), depth+1)
+		}
+		return size
+	case reflect.Struct:
+		size := 0
+		for i := 0; i < v.NumField(); i++ {
+			size += sizeOf(v.Field(i), depth+1)
+		}
+		return size
+	default:
+		return int(v.Type().Size())
+	}
+}
@@ -0,0 +1,163 @@
+package cachify
+
+import (
+	"container/list"
+	"sort"
+	"time"
+)
+
+// Snapshot captures the full content of the cache.
+//
+// Returns:
+//   - A slice of `Entry` values ordered from most to least recently used, including
+//     absolute expiration times, access metadata, tags, and pin state.
+//
+// Details:
+//   - Uses read locking and does not modify the order of items in the cache.
+//   - Values are copied by reference unless a Copier is set; snapshotting does not deep-copy them.
+func (c *LRU) Snapshot() []Entry {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	snapshot := make([]Entry, 0, len(c.cache))
+	for element := c.list.Front(); element != nil; element = element.Next() {
+		snapshot = append(snapshot, c.entryOf(element.Value.(*entries)))
+	}
+	return snapshot
+}
+
+// Export returns one page of the live entries, in lexical key order.
+//
+// Parameters:
+//   - offset: The number of matching entries to skip. Negative values count as zero.
+//   - limit: The maximum number of entries to return. Zero or less returns every remaining entry.
+//   - filter: An optional function selecting the keys to export; nil selects every key.
+//
+// Returns:
+//   - The entries of the page, in the same form as Snapshot.
+//   - The total number of matching entries, for paging controls.
+//
+// Details:
+//   - Pages are ordered by key rather than by recency, so they stay stable while reads reorder the
+//     cache; entries added or removed between calls shift the following pages.
+//   - Only the keys are collected and sorted; values and metadata are copied for the returned page
+//     alone, so showing 20 rows of a large cache does not copy it.
+//   - Expired entries are skipped. Uses read locking and does not modify the order of items.
+func (c *LRU) Export(offset, limit int, filter func(key string) bool) ([]Entry, int) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	now := time.Now()
+	keys := make([]string, 0, len(c.cache))
+	for key, element := range c.cache {
+		if expired(element.Value.(*entries), now) || (filter != nil && !filter(key)) {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	total := len(keys)
+	offset = min(max(offset, 0), total)
+	end := total
+	if limit > 0 {
+		end = min(offset+limit, total)
+	}
+	page := make([]Entry, 0, end-offset)
+	for _, key := range keys[offset:end] {
+		page = append(page, c.entryOf(c.cache[key].Value.(*entries)))
+	}
+	return page, total
+}
+
+// entryOf copies an entry into an Entry value.
+//
+// Details:
+//   - Must be called with the read or write lock held.
+func (c *LRU) entryOf(entry *entries) Entry {
+	return Entry{
+		Key:         entry.key,
+		Value:       c.view(entry.value),
+		Expiration:  entry.expiration,
+		AccessTime:  entry.accessTime,
+		AccessCount: entry.accessCount,
+		Tags:        append([]string(nil), entry.tags...),
+		Pinned:      entry.pinned,
+		Meta:        copyMeta(entry.meta),
+		Persistent:  entry.persistent,
+		Deadline:    entry.deadline,
+	}
+}
+
+// Restore replaces the content of the cache with a snapshot.
+//
+// Parameters:
+//   - snapshot: Entries ordered from most to least recently used, as returned by Snapshot.
+//
+// Returns:
+//   - The number of entries restored.
+//
+// Details:
+//   - Existing entries are discarded like Clear: the eviction callback is not invoked, but per-entry
+//     callbacks and the automatic close (see WithAutoClose) are, and one EventClear is reported.
+//   - Recency order and absolute expiration times are preserved; entries that have already
+//     expired are skipped.
+//   - If the snapshot holds more entries than the capacity, the least recently used ones are dropped.
+func (c *LRU) Restore(snapshot []Entry) int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for element := c.list.Front(); element != nil; element = element.Next() {
+		c.replace(element.Value.(*entries), nil)
+	}
+	size := len(snapshot)
+	if c.capacity > 0 {
+		size = min(size, c.capacity)
+	}
+	c.cache = make(map[string]*list.Element, mapHint(size))
+	c.list.Init()
+	c.tags = nil
+	c.index = nil
+	c.hashes = nil
+	c.interned = nil
+	c.dedupSaved = 0
+	c.prefixes = nil
+	c.sweepCursor = nil
+	c.emit(EventClear, "", nil)
+
+	now := time.Now()
+	for _, e := range snapshot {
+		if c.capacity > 0 && len(c.cache) >= c.capacity {
+			break
+		}
+		if _, exists := c.cache[e.Key]; exists {
+			continue
+		}
+		value, err := c.stored(e.Value)
+		if err != nil {
+			continue
+		}
+		entry := &entries{
+			key:         e.Key,
+			value:       value,
+			expiration:  e.Expiration,
+			accessTime:  e.AccessTime,
+			accessCount: e.AccessCount,
+			tags:        append([]string(nil), e.Tags...),
+			pinned:      e.Pinned,
+			meta:        copyMeta(e.Meta),
+			version:     c.nextVersion(),
+			persistent:  e.Persistent,
+			deadline:    e.Deadline,
+		}
+		if expired(entry, now) {
+			continue
+		}
+		entry.value = c.intern(entry.value)
+		// Snapshots are ordered MRU first, so each entry goes behind the previous one
+		c.cache[e.Key] = c.list.PushBack(entry)
+		c.tag(entry)
+		c.reindex(entry, e.Value)
+	}
+	c.orderQuotas()
+	return len(c.cache)
+}
@@ -0,0 +1,112 @@
+package cachify
+
+import (
+	"context"
+	"time"
+)
+
+// WithStaleIfError serves the last known value of a key when reloading it fails, instead of the error.
+//
+// Parameters:
+//   - maxStale: How long past its expiry a value may still be served. Zero or less disables stale-if-error.
+//
+// Returns:
+//   - The Loading cache, for chaining.
+//
+// Details:
+//   - Entries that expire are kept aside for maxStale; if the Loader then fails for the key, Get returns
+//     the expired value with a nil error, and GetStale returns it flagged along with the Loader's error.
+//   - An entry being reloaded early (see WithEarlyExpiration) is not expired yet, so its current value is
+//     served if the reload fails, regardless of maxStale.
+//   - Only expirations keep a value aside: a key removed, evicted or cleared before it expires is never
+//     served stale.
+//     A successful load discards the kept value.
+//   - The cache hands expired values to a single Loading cache; enabling this on a second Loading cache
+//     over the same LRU takes them over.
+func (l *Loading) WithStaleIfError(maxStale time.Duration) *Loading {
+	l.mutex.Lock()
+	l.maxStale = max(maxStale, 0)
+	l.stale = nil
+	l.mutex.Unlock()
+	var hook func(key string, value interface{}, expiration time.Time)
+	if maxStale > 0 {
+		hook = l.keepStale
+	}
+	l.cache.mutex.Lock()
+	l.cache.onStale = hook
+	l.cache.mutex.Unlock()
+	return l
+}
+
+// GetStale retrieves the value associated with a given key like Get, reporting whether it is stale.
+//
+// Parameters:
+//   - ctx: The context passed to the Loader.
+//   - key: The key whose value is to be retrieved.
+//
+// Returns:
+//   - The cached, loaded or stale value.
+//   - True if loading failed and a stale value is returned instead (see WithStaleIfError).
+//   - The Loader's error. It is also returned alongside a stale value, which remains usable.
+func (l *Loading) GetStale(ctx context.Context, key string) (interface{}, bool, error) {
+	cached, ok := l.cache.Get(key)
+	if ok && !l.expiresEarly(key) {
+		return cached, false, nil
+	}
+	if !ok && l.knownAbsent(l.cache.normalizeKey(key)) {
+		return nil, false, ErrNotFound
+	}
+	value, err := l.load(ctx, key)
+	if err == nil {
+		return value, false, nil
+	}
+	if ok {
+		// An early reload failed, but the cached value has not expired yet
+		return cached, false, nil
+	}
+	l.mutex.Lock()
+	maxStale := l.maxStale
+	kept, found := l.stale[l.cache.normalizeKey(key)]
+	l.mutex.Unlock()
+	switch {
+	case maxStale == 0:
+		return nil, false, err
+	case found && time.Since(kept.expiration) <= maxStale:
+		return kept.value, true, err
+	default:
+		return nil, false, err
+	}
+}
+
+// keepStale records an expired value so it can be served if reloading it fails.
+//
+// Details:
+//   - Called by the cache with its write lock held; values older than maxStale are pruned at most
+//     once per maxStale.
+func (l *Loading) keepStale(key string, value interface{}, expiration time.Time) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if l.maxStale == 0 {
+		return
+	}
+	now := time.Now()
+	if now.After(l.staleSweep) {
+		for k, kept := range l.stale {
+			if now.Sub(kept.expiration) > l.maxStale {
+				delete(l.stale, k)
+			}
+		}
+		l.staleSweep = now.Add(l.maxStale)
+	}
+	if l.stale == nil {
+		l.stale = make(map[string]staleValue)
+	}
+	l.stale[key] = staleValue{value: value, expiration: expiration}
+}
+
+// dropStale discards the value kept aside for a key once it has been loaded again.
+func (l *Loading) dropStale(key string) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	delete(l.stale, l.cache.normalizeKey(key))
+}
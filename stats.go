@@ -0,0 +1,153 @@
+package cachify
+
+// Stats returns the usage counters of the cache.
+//
+// Returns:
+//   - A copy of the hit, miss, eviction, and expiration counters and the current length.
+//
+// Details:
+//   - Hits and misses are counted by Get and GetE; other reads do not affect them.
+//   - Latency is set only when latency tracking is enabled (see WithLatencyTracking).
+func (c *LRU) Stats() Stats {
+	stats := c.stats.snapshot(c.Len())
+	if l := c.latency.Load(); l != nil {
+		stats.Latency = l.snapshot()
+	}
+	return stats
+}
+
+// Stats returns the usage counters of the cache.
+func (c *Clock) Stats() Stats {
+	return c.stats.snapshot(c.Len())
+}
+
+// Stats returns the usage counters of the cache.
+func (c *LRUK) Stats() Stats {
+	return c.stats.snapshot(c.Len())
+}
+
+// Stats returns the usage counters of the in-memory tier.
+//
+// Details:
+//   - An L1 miss that is served from L2 counts as an L1 miss.
+func (t *Tiered) Stats() Stats {
+	return t.l1.Stats()
+}
+
+// ResetStats sets every counter back to zero, including pool statistics, latency histograms, and the stats window.
+//
+// Details:
+//   - Useful to measure a phase of the workload, e.g. after warm-up, without recreating the cache.
+func (c *LRU) ResetStats() {
+	c.stats.reset()
+	if namespaces := c.namespaces.Load(); namespaces != nil {
+		for _, stats := range *namespaces {
+			stats.reset()
+		}
+	}
+	if c.latency.Load() != nil {
+		c.latency.Store(&latencies{})
+	}
+}
+
+// ResetStats sets every counter back to zero.
+func (c *Clock) ResetStats() {
+	c.stats.reset()
+}
+
+// ResetStats sets every counter back to zero.
+func (c *LRUK) ResetStats() {
+	c.stats.reset()
+}
+
+// ResetStats sets every counter of every stripe back to zero.
+func (s *Striped) ResetStats() {
+	for _, stripe := range s.stripes {
+		stripe.ResetStats()
+	}
+}
+
+// ResetStats sets every counter of the in-memory tier back to zero.
+func (t *Tiered) ResetStats() {
+	t.l1.ResetStats()
+}
+
+// ResetStats sets the miss counter back to zero.
+func (n *Noop) ResetStats() {
+	n.stats.reset()
+}
+
+// ResetStats sets every counter back to zero.
+func (p *PassThrough) ResetStats() {
+	p.stats.reset()
+}
+
+// HitRatio returns the fraction of lookups served from the cache, or zero if there were none.
+func (s Stats) HitRatio() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+// snapshot copies the counters into a Stats value.
+func (s *counters) snapshot(length int) Stats {
+	return Stats{
+		Hits:        s.hits.Load(),
+		Misses:      s.misses.Load(),
+		Evictions:   s.evictions.Load(),
+		Expirations: s.expirations.Load(),
+		Panics:      s.panics.Load(),
+		Len:         length,
+	}
+}
+
+// reset sets every counter back to zero and empties the stats window, if any.
+func (s *counters) reset() {
+	s.hits.Store(0)
+	s.misses.Store(0)
+	s.evictions.Store(0)
+	s.expirations.Store(0)
+	s.allocated.Store(0)
+	s.reused.Store(0)
+	s.panics.Store(0)
+	if w := s.window.Load(); w != nil {
+		s.window.CompareAndSwap(w, &statsWindow{
+			width:   w.width,
+			buckets: make([]windowBucket, len(w.buckets)),
+		})
+	}
+}
+
+// hit counts a lookup served from the cache.
+func (s *counters) hit() {
+	s.hits.Add(1)
+	if w := s.window.Load(); w != nil {
+		w.current().hits.Add(1)
+	}
+}
+
+// miss counts a lookup that found no live entry.
+func (s *counters) miss() {
+	s.misses.Add(1)
+	if w := s.window.Load(); w != nil {
+		w.current().misses.Add(1)
+	}
+}
+
+// evict counts an entry removed to respect the capacity.
+func (s *counters) evict() {
+	s.evictions.Add(1)
+	if w := s.window.Load(); w != nil {
+		w.current().evictions.Add(1)
+	}
+}
+
+// expire counts an entry removed because it expired.
+func (s *counters) expire() {
+	s.expirations.Add(1)
+	if w := s.window.Load(); w != nil {
+		w.current().expirations.Add(1)
+	}
+}
@@ -0,0 +1,148 @@
+package cachify
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// WithNamespaceStats tracks usage counters separately for keys under the given prefixes.
+//
+// Parameters:
+//   - prefixes: The namespace prefixes to track, e.g. "tenant:42:". Prefixes already tracked keep
+//     their counters.
+//
+// Returns:
+//   - The LRU cache, for chaining.
+//
+// Details:
+//   - Lookups, capacity evictions, and expirations of a key are counted in every tracked namespace
+//     whose prefix it starts with, in addition to the cache-wide counters.
+//   - Each lookup then checks the key against every tracked prefix: track tenants or features, not
+//     thousands of prefixes.
+//   - Read them with NamespaceStats, View.Stats, or StatsTree.
+func (c *LRU) WithNamespaceStats(prefixes ...string) *LRU {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	namespaces := make(map[string]*counters)
+	if current := c.namespaces.Load(); current != nil {
+		for prefix, stats := range *current {
+			namespaces[prefix] = stats
+		}
+	}
+	for _, prefix := range prefixes {
+		if _, exists := namespaces[prefix]; !exists {
+			namespaces[prefix] = &counters{}
+		}
+	}
+	c.namespaces.Store(&namespaces)
+	return c
+}
+
+// NamespaceStats returns the usage counters of a namespace tracked with WithNamespaceStats.
+//
+// Parameters:
+//   - prefix: The namespace prefix.
+//
+// Returns:
+//   - The counters of the namespace, with Len set to the number of entries under the prefix.
+//   - A boolean indicating whether the namespace is tracked.
+func (c *LRU) NamespaceStats(prefix string) (Stats, bool) {
+	namespaces := c.namespaces.Load()
+	if namespaces == nil {
+		return Stats{}, false
+	}
+	stats, exists := (*namespaces)[prefix]
+	if !exists {
+		return Stats{}, false
+	}
+	return stats.snapshot(c.LenByPrefix(prefix)), true
+}
+
+// Stats returns the usage counters of the namespace.
+//
+// Details:
+//   - Counters stay at zero unless the namespace is tracked with LRU.WithNamespaceStats; Len is
+//     always the number of entries in the namespace.
+func (v *View) Stats() Stats {
+	if stats, ok := v.cache.NamespaceStats(v.prefix); ok {
+		return stats
+	}
+	return Stats{Len: v.Len()}
+}
+
+// StatsTree returns the cache-wide counters with the counters of each tracked namespace as children.
+//
+// Returns:
+//   - A tree whose root holds Stats() and whose children, sorted by prefix, are named after the
+//     namespace prefixes tracked with WithNamespaceStats.
+func (c *LRU) StatsTree() StatsTree {
+	tree := StatsTree{Stats: c.Stats()}
+	namespaces := c.namespaces.Load()
+	if namespaces == nil {
+		return tree
+	}
+	prefixes := make([]string, 0, len(*namespaces))
+	for prefix := range *namespaces {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+	for _, prefix := range prefixes {
+		stats, _ := c.NamespaceStats(prefix)
+		tree.Children = append(tree.Children, StatsTree{Name: prefix, Stats: stats})
+	}
+	return tree
+}
+
+// StripeStats returns the usage counters of each stripe, to spot hot stripes.
+//
+// Returns:
+//   - One Stats per stripe, in stripe order (see Stripe).
+func (s *Striped) StripeStats() []Stats {
+	stats := make([]Stats, len(s.stripes))
+	for i, stripe := range s.stripes {
+		stats[i] = stripe.Stats()
+	}
+	return stats
+}
+
+// StatsTree returns the counters summed across stripes, with one child per stripe.
+//
+// Returns:
+//   - A tree whose root holds Stats() and whose children, named after the stripe index, hold the
+//     StatsTree of each stripe, including its tracked namespaces.
+func (s *Striped) StatsTree() StatsTree {
+	tree := StatsTree{Stats: s.Stats(), Children: make([]StatsTree, len(s.stripes))}
+	for i, stripe := range s.stripes {
+		tree.Children[i] = stripe.StatsTree()
+		tree.Children[i].Name = strconv.Itoa(i)
+	}
+	return tree
+}
+
+// WithNamespaceStats tracks usage counters separately for keys under the given prefixes, in every stripe.
+//
+// Returns:
+//   - The Striped cache, for chaining.
+//
+// Details:
+//   - See LRU.WithNamespaceStats.
+func (s *Striped) WithNamespaceStats(prefixes ...string) *Striped {
+	for _, stripe := range s.stripes {
+		stripe.WithNamespaceStats(prefixes...)
+	}
+	return s
+}
+
+// countNamespaces applies a counter update to every tracked namespace of a key.
+func (c *LRU) countNamespaces(key string, count func(*counters)) {
+	namespaces := c.namespaces.Load()
+	if namespaces == nil {
+		return
+	}
+	for prefix, stats := range *namespaces {
+		if strings.HasPrefix(key, prefix) {
+			count(stats)
+		}
+	}
+}
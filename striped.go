@@ -0,0 +1,240 @@
+package cachify
+
+import (
+	"time"
+)
+
+var _ Cache = (*Striped)(nil)
+
+// NewStriped creates a new striped cache.
+//
+// Parameters:
+//   - capacity: The total number of items the cache can hold, split evenly across stripes. Zero or less means unbounded.
+//   - stripes: The number of stripes, rounded up to a power of two. Zero or less uses 16. A bounded cache
+//     uses at most capacity stripes, halving the count until every stripe holds at least one item.
+//
+// Returns:
+//   - A pointer to an initialized Striped cache using DefaultHasher.
+func NewStriped(capacity, stripes int) *Striped {
+	if stripes <= 0 {
+		stripes = defaultStripes
+	}
+	n := 1
+	for n < stripes {
+		n <<= 1
+	}
+	for capacity > 0 && n > capacity {
+		n >>= 1
+	}
+	s := &Striped{
+		stripes: make([]*LRU, n),
+		hasher:  DefaultHasher,
+		base:    DefaultHasher,
+		mask:    uint64(n - 1),
+	}
+	for i := range s.stripes {
+		share := capacity
+		if capacity > 0 {
+			// Spread the remainder over the first stripes so the shares add up to the capacity
+			share = capacity / n
+			if i < capacity%n {
+				share++
+			}
+		}
+		s.stripes[i] = NewLRU(share)
+	}
+	return s
+}
+
+// NewStripedExpires creates a new striped cache whose entries expire.
+//
+// Parameters:
+//   - capacity: The total number of items the cache can hold.
+//   - stripes: The number of stripes, rounded up to a power of two.
+//   - expiry: The duration after which entries expire.
+//
+// Returns:
+//   - A pointer to an initialized Striped cache; each stripe runs its own background cleanup.
+func NewStripedExpires(capacity, stripes int, expiry time.Duration) *Striped {
+	s := NewStriped(capacity, stripes)
+	for _, stripe := range s.stripes {
+		stripe.SetExpiry(expiry)
+	}
+	return s
+}
+
+// WithHasher sets the function mapping keys to stripes.
+//
+// Parameters:
+//   - hasher: The hash function. Nil restores DefaultHasher.
+//
+// Returns:
+//   - The Striped cache, for chaining.
+//
+// Details:
+//   - Must be configured before the cache is used, since it changes where keys live; use Reseed to
+//     change the spread of a populated cache, which mixes a seed into this hasher.
+func (s *Striped) WithHasher(hasher Hasher) *Striped {
+	if hasher == nil {
+		hasher = DefaultHasher
+	}
+	s.route.Lock()
+	defer s.route.Unlock()
+	s.hasher, s.base, s.seed = hasher, hasher, 0
+	return s
+}
+
+// WithKeyTransform sets a function canonicalizing every key before it is routed to a stripe.
+//
+// Parameters:
+//   - transform: An idempotent function such as strings.ToLower or TrimLower. Nil disables it.
+//
+// Returns:
+//   - The Striped cache, for chaining.
+//
+// Details:
+//   - The key is transformed once before it is hashed, in Get, Set, Remove, Contains, and Stripe, so
+//     variants of a key share one stripe and one entry. Set it here rather than on individual stripes.
+//   - Every stripe gets the same transform, so LRU methods called through Stripe accept the same keys.
+//   - Configure it before the cache is shared: keys already stored are not transformed.
+func (s *Striped) WithKeyTransform(transform KeyTransform) *Striped {
+	s.route.Lock()
+	defer s.route.Unlock()
+	s.keyTransform = transform
+	for _, stripe := range s.stripes {
+		stripe.WithKeyTransform(transform)
+	}
+	return s
+}
+
+// Stripes returns the number of stripes.
+func (s *Striped) Stripes() int {
+	return len(s.stripes)
+}
+
+// Stripe returns the LRU stripe holding a key, giving access to the full LRU API for that key.
+// The stripe stays the key's home only until the next Reseed.
+func (s *Striped) Stripe(key string) *LRU {
+	s.route.RLock()
+	defer s.route.RUnlock()
+	key = s.normalizeKey(key)
+	return s.stripe(key)
+}
+
+// Get retrieves the value associated with a given key.
+func (s *Striped) Get(key string) (value interface{}, ok bool) {
+	s.route.RLock()
+	defer s.route.RUnlock()
+	key = s.normalizeKey(key)
+	return s.stripe(key).Get(key)
+}
+
+// Set inserts or updates a key-value pair, evicting the least recently used entry of its stripe if needed.
+func (s *Striped) Set(key string, value interface{}) {
+	s.route.RLock()
+	defer s.route.RUnlock()
+	key = s.normalizeKey(key)
+	s.stripe(key).Set(key, value)
+}
+
+// Remove deletes a key from the cache.
+func (s *Striped) Remove(key string) {
+	s.route.RLock()
+	defer s.route.RUnlock()
+	key = s.normalizeKey(key)
+	s.stripe(key).Remove(key)
+}
+
+// Contains checks whether a key exists in the cache.
+func (s *Striped) Contains(key string) bool {
+	s.route.RLock()
+	defer s.route.RUnlock()
+	key = s.normalizeKey(key)
+	return s.stripe(key).Contains(key)
+}
+
+// normalizeKey applies the key transform, if any.
+//
+// Details:
+//   - Must be called with the route lock held.
+func (s *Striped) normalizeKey(key string) string {
+	if s.keyTransform == nil {
+		return key
+	}
+	return s.keyTransform(key)
+}
+
+// stripe returns the LRU stripe holding a key.
+//
+// Details:
+//   - Must be called with the route lock held.
+func (s *Striped) stripe(key string) *LRU {
+	return s.stripes[s.hasher(key)&s.mask]
+}
+
+// Len returns the number of items across all stripes.
+func (s *Striped) Len() int {
+	s.route.RLock()
+	defer s.route.RUnlock()
+	n := 0
+	for _, stripe := range s.stripes {
+		n += stripe.Len()
+	}
+	return n
+}
+
+// Capacity returns the total capacity across all stripes.
+func (s *Striped) Capacity() int {
+	n := 0
+	for _, stripe := range s.stripes {
+		n += stripe.Capacity()
+	}
+	return n
+}
+
+// Keys returns the keys of every stripe, each stripe ordered from most to least recently used.
+func (s *Striped) Keys() []string {
+	s.route.RLock()
+	defer s.route.RUnlock()
+	var keys []string
+	for _, stripe := range s.stripes {
+		keys = append(keys, stripe.Keys()...)
+	}
+	return keys
+}
+
+// Clear removes all items from every stripe.
+func (s *Striped) Clear() {
+	for _, stripe := range s.stripes {
+		stripe.Clear()
+	}
+}
+
+// SetCallback sets the eviction callback on every stripe.
+func (s *Striped) SetCallback(callback OnCallback) {
+	for _, stripe := range s.stripes {
+		stripe.SetCallback(callback)
+	}
+}
+
+// Stats returns the usage counters summed across stripes.
+func (s *Striped) Stats() Stats {
+	var total Stats
+	for _, stripe := range s.stripes {
+		stats := stripe.Stats()
+		total.Hits += stats.Hits
+		total.Misses += stats.Misses
+		total.Evictions += stats.Evictions
+		total.Expirations += stats.Expirations
+		total.Panics += stats.Panics
+		total.Len += stats.Len
+	}
+	return total
+}
+
+// Close closes every stripe, stopping their background cleanup.
+func (s *Striped) Close() {
+	for _, stripe := range s.stripes {
+		stripe.Close()
+	}
+}
@@ -0,0 +1,108 @@
+package cachify
+
+// SetWithTags inserts or updates a key-value pair and associates it with one or more tags.
+//
+// Parameters:
+//   - key: The key to be added or updated.
+//   - value: The value to be associated with the key.
+//   - tags: The tags to attach to the entry. They replace any tags previously attached to the key.
+//
+// Details:
+//   - Behaves like Set regarding recency, expiration, and capacity eviction.
+//   - A plain Set on a tagged key keeps its existing tags.
+func (c *LRU) SetWithTags(key string, value interface{}, tags ...string) {
+	key = c.normalizeKey(key)
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry := c.set(key, value)
+	if entry == nil {
+		return
+	}
+	c.untag(entry)
+	entry.tags = append([]string(nil), tags...)
+	c.tag(entry)
+	c.enforceQuotas(key)
+	c.enforceCapacity(c.cache[key])
+}
+
+// InvalidateTag removes every entry carrying the given tag.
+//
+// Parameters:
+//   - tag: The tag whose entries should be removed.
+//
+// Returns:
+//   - The number of entries removed.
+//
+// Details:
+//   - Runs in O(entries-with-tag) using the tag index, not a full scan.
+//   - The eviction callback is invoked for every removed entry.
+func (c *LRU) InvalidateTag(tag string) int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	keys := c.tags[tag]
+	removed := 0
+	for key := range keys {
+		if element, exists := c.cache[key]; exists {
+			c.evict(element)
+			removed++
+		}
+	}
+	return removed
+}
+
+// Tags returns the tags associated with a key.
+//
+// Returns:
+//   - A copy of the entry's tags, or nil if the key does not exist or carries no tags.
+func (c *LRU) Tags(key string) []string {
+	key = c.normalizeKey(key)
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	if element, exists := c.cache[key]; exists {
+		entry := element.Value.(*entries)
+		if len(entry.tags) > 0 {
+			return append([]string(nil), entry.tags...)
+		}
+	}
+	return nil
+}
+
+// tag adds an entry's key to the index of each of its tags.
+//
+// Details:
+//   - Must be called with the write lock held.
+func (c *LRU) tag(entry *entries) {
+	if len(entry.tags) == 0 {
+		return
+	}
+	if c.tags == nil {
+		c.tags = make(map[string]map[string]struct{})
+	}
+	for _, t := range entry.tags {
+		keys, exists := c.tags[t]
+		if !exists {
+			keys = make(map[string]struct{})
+			c.tags[t] = keys
+		}
+		keys[entry.key] = struct{}{}
+	}
+}
+
+// untag removes an entry's key from the index of each of its tags.
+//
+// Details:
+//   - Must be called with the write lock held.
+//   - Drops tags that no longer carry any key.
+func (c *LRU) untag(entry *entries) {
+	for _, t := range entry.tags {
+		if keys, exists := c.tags[t]; exists {
+			delete(keys, entry.key)
+			if len(keys) == 0 {
+				delete(c.tags, t)
+			}
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test buffered access applies recency in batches
+func TestLRU_WithBufferedAccess(t *testing.T) {
+	cache := cachify.NewLRU(3).WithBufferedAccess(2)
+	assert.True(t, cache.IsBufferedAccess())
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+	cache.Set("c", 3)
+
+	value, ok := cache.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, value)
+	// The first access is still buffered, so "a" has not moved yet
+	assert.Equal(t, []string{"c", "b", "a"}, cache.Keys())
+
+	for i := 0; i < 19; i++ {
+		cache.Get("a")
+	}
+	assert.Equal(t, []string{"a", "c", "b"}, cache.Keys())
+
+	_, ok = cache.Get("missing")
+	assert.False(t, ok)
+	stats := cache.Stats()
+	assert.Equal(t, uint64(20), stats.Hits)
+	assert.Equal(t, uint64(1), stats.Misses)
+
+	cache.WithoutBufferedAccess()
+	cache.Get("b")
+	assert.Equal(t, []string{"b", "a", "c"}, cache.Keys())
+}
+
+// Test buffered access skips entries removed before the drain and is safe under concurrency
+func TestLRU_WithBufferedAccess_Concurrent(t *testing.T) {
+	cache := cachify.NewLRU(2).WithBufferedAccess(2)
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+	for i := 0; i < 20; i++ {
+		cache.Get("a")
+		cache.Remove("a")
+		cache.Get("b")
+	}
+	assert.Equal(t, []string{"b"}, cache.Keys())
+
+	cache = cachify.NewLRU(50).WithBufferedAccess(0)
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				key := fmt.Sprintf("k%d", (g*7+i)%80)
+				if _, ok := cache.Get(key); !ok {
+					cache.Set(key, i)
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+	assert.Equal(t, 50, cache.Len())
+	stats := cache.Stats()
+	assert.Equal(t, uint64(8000), stats.Hits+stats.Misses)
+}
+
+// Test buffered reads of removed entries never touch entries another cache reuses from the pool
+func TestLRU_WithBufferedAccess_PoolReuse(t *testing.T) {
+	buffered := cachify.NewLRU(4).WithBufferedAccess(4)
+	churn := cachify.NewLRU(4)
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 2000; i++ {
+			key := fmt.Sprintf("k%d", i%8)
+			buffered.Set(key, i)
+			buffered.Get(key)
+			buffered.Remove(key)
+			buffered.Get("k0")
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 2000; i++ {
+			churn.Set(fmt.Sprintf("c%d", i), i)
+		}
+	}()
+	wg.Wait()
+	assert.Equal(t, 0, buffered.Len())
+	assert.Equal(t, 4, churn.Len())
+}
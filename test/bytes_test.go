@@ -0,0 +1,33 @@
+package test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test binary keys share the string key space without allocating on lookups
+func TestLRU_BytesKeys(t *testing.T) {
+	c := cachify.NewLRU(10)
+	hash := sha256.Sum256([]byte("payload"))
+	key := hash[:]
+
+	c.SetBytes(key, "value")
+	value, ok := c.Get(string(key))
+	assert.True(t, ok)
+	assert.Equal(t, "value", value)
+	assert.True(t, c.ContainsBytes(key))
+
+	allocs := testing.AllocsPerRun(100, func() {
+		c.GetBytes(key)
+	})
+	assert.Equal(t, 0.0, allocs)
+
+	key[0] ^= 0xff
+	assert.False(t, c.ContainsBytes(key))
+	key[0] ^= 0xff
+	c.RemoveBytes(key)
+	assert.Equal(t, 0, c.Len())
+}
@@ -0,0 +1,55 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test every implementation behaves the same through the Cache interface
+func TestCache_Implementations(t *testing.T) {
+	caches := map[string]cachify.Cache{
+		"lru":    cachify.NewLRU(2),
+		"clock":  cachify.NewClock(2),
+		"lruk":   cachify.NewLRUK(2, 2),
+		"tiered": cachify.NewTiered(cachify.NewLRU(2), newMemoryStore()),
+	}
+	for name, c := range caches {
+		c.Set("a", 1)
+		c.Set("b", 2)
+		value, ok := c.Get("a")
+		assert.True(t, ok, name)
+		assert.Equal(t, 1, value, name)
+		c.Set("c", 3)
+		assert.Equal(t, 2, c.Len(), name)
+		c.Remove("c")
+		assert.False(t, c.Contains("c"), name)
+
+		stats := c.Stats()
+		assert.Equal(t, uint64(1), stats.Hits, name)
+		assert.Equal(t, uint64(1), stats.Evictions, name)
+		assert.Equal(t, 1, stats.Len, name)
+
+		c.Clear()
+		assert.Equal(t, 0, c.Len(), name)
+		c.Close()
+	}
+}
+
+// Test LRU stats count misses and expirations
+func TestLRU_Stats(t *testing.T) {
+	c := cachify.NewLRUExpiresLazy(10, 10*time.Millisecond)
+	c.Set("a", 1)
+	c.Get("a")
+	c.Get("missing")
+	time.Sleep(20 * time.Millisecond)
+	c.Get("a")
+
+	stats := c.Stats()
+	assert.Equal(t, uint64(1), stats.Hits)
+	assert.Equal(t, uint64(2), stats.Misses)
+	assert.Equal(t, uint64(1), stats.Expirations)
+	assert.InDelta(t, 1.0/3, stats.HitRatio(), 0.001)
+}
@@ -0,0 +1,81 @@
+package test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/pnguyen215/cachify/cachifydebug"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test the debug handler lists caches, shows entries and removes them
+func TestCachifyDebug_Handler(t *testing.T) {
+	cache := cachify.NewLRU(10)
+	cache.Set("user/1", map[string]string{"name": "Ada"})
+	cache.Get("user/1")
+	cachify.Register("debug-users", cache)
+	defer cachify.Unregister("debug-users")
+
+	mux := http.NewServeMux()
+	mux.Handle("/debug/cachify/", http.StripPrefix("/debug/cachify", cachifydebug.NewHandler()))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	response, err := http.Get(server.URL + "/debug/cachify/")
+	assert.Nil(t, err)
+	var caches []map[string]interface{}
+	assert.Nil(t, json.NewDecoder(response.Body).Decode(&caches))
+	response.Body.Close()
+	names := make([]interface{}, 0, len(caches))
+	for _, c := range caches {
+		names = append(names, c["name"])
+	}
+	assert.Contains(t, names, "debug-users")
+
+	response, err = http.Get(server.URL + "/debug/cachify/debug-users")
+	assert.Nil(t, err)
+	var info map[string]interface{}
+	assert.Nil(t, json.NewDecoder(response.Body).Decode(&info))
+	response.Body.Close()
+	assert.Equal(t, float64(10), info["capacity"])
+	assert.Equal(t, "user/1", info["hot"].([]interface{})[0].(map[string]interface{})["key"])
+
+	response, err = http.Get(server.URL + "/debug/cachify/debug-users/keys/user/1")
+	assert.Nil(t, err)
+	var entry map[string]interface{}
+	assert.Nil(t, json.NewDecoder(response.Body).Decode(&entry))
+	response.Body.Close()
+	assert.Equal(t, map[string]interface{}{"name": "Ada"}, entry["value"])
+	assert.Equal(t, uint64(1), cache.Stats().Hits)
+
+	request, _ := http.NewRequest(http.MethodDelete, server.URL+"/debug/cachify/debug-users/keys/user/1", nil)
+	response, err = http.DefaultClient.Do(request)
+	assert.Nil(t, err)
+	response.Body.Close()
+	assert.Equal(t, http.StatusNoContent, response.StatusCode)
+	assert.False(t, cache.Contains("user/1"))
+
+	response, err = http.Get(server.URL + "/debug/cachify/missing")
+	assert.Nil(t, err)
+	response.Body.Close()
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+}
+
+// Test the debug handler can redact values
+func TestCachifyDebug_Redaction(t *testing.T) {
+	cache := cachify.NewLRU(10)
+	cache.Set("token", "secret")
+	cachify.Register("debug-secrets", cache)
+	defer cachify.Unregister("debug-secrets")
+
+	recorder := httptest.NewRecorder()
+	cachifydebug.NewHandler().WithRedaction(true).
+		ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/debug-secrets/keys/token", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.False(t, strings.Contains(recorder.Body.String(), "secret\""))
+	assert.Contains(t, recorder.Body.String(), "[redacted]")
+}
@@ -0,0 +1,45 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pnguyen215/cachify/cachifytest"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test the fake scripts lookups, records calls and follows the manual clock
+func TestFake(t *testing.T) {
+	clock := cachifytest.NewManualClock(time.Time{})
+	var seen []string
+	f := cachifytest.New().WithClock(clock).WithTTL(time.Minute).OnCall(func(call cachifytest.Call) {
+		seen = append(seen, call.Method)
+	})
+
+	f.Set("a", 1)
+	value, ok := f.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, value)
+
+	f.ForceMiss("a")
+	_, ok = f.Get("a")
+	assert.False(t, ok)
+	f.ForceHit("ghost", "boo")
+	value, _ = f.Get("ghost")
+	assert.Equal(t, "boo", value)
+
+	f.Unscript("a")
+	clock.Advance(2 * time.Minute)
+	_, ok = f.Get("a")
+	assert.False(t, ok)
+
+	assert.Equal(t, 4, f.CallCount("Get"))
+	assert.Equal(t, []string{"Set", "Get", "Get", "Get", "Get"}, seen)
+	calls := f.Calls()
+	assert.Equal(t, "a", calls[1].Key)
+	assert.True(t, calls[1].Hit)
+	stats := f.Stats()
+	assert.Equal(t, uint64(2), stats.Hits)
+	assert.Equal(t, uint64(2), stats.Misses)
+	assert.Equal(t, uint64(1), stats.Expirations)
+}
@@ -0,0 +1,38 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test SetWithCallback releases a value on every way it can leave the cache
+func TestLRU_SetWithCallback(t *testing.T) {
+	var global, released []interface{}
+	cache := cachify.NewLRUCallback(2, func(key string, value interface{}) {
+		global = append(global, value)
+	})
+	release := func(key string, value interface{}) {
+		released = append(released, value)
+	}
+
+	cache.SetWithCallback("a", "conn-a", release)
+	cache.Set("b", "plain")
+	cache.Set("c", "plain")
+	assert.Equal(t, []interface{}{"conn-a"}, global)
+	assert.Equal(t, []interface{}{"conn-a"}, released)
+
+	cache.SetWithCallback("b", "conn-b1", release)
+	cache.Set("b", "conn-b2")
+	assert.Equal(t, []interface{}{"conn-a", "conn-b1"}, released)
+	cache.Remove("b")
+	assert.Equal(t, []interface{}{"conn-a", "conn-b1"}, released)
+
+	cache.SetWithCallback("d", "conn-d", release)
+	clone := cache.Clone(false)
+	clone.Clear()
+	assert.Equal(t, []interface{}{"conn-a", "conn-b1"}, released)
+	cache.Clear()
+	assert.Equal(t, []interface{}{"conn-a", "conn-b1", "conn-d"}, released)
+}
@@ -0,0 +1,52 @@
+package test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test CLOCK gives referenced entries a second chance
+func TestClock_SecondChance(t *testing.T) {
+	var evicted []string
+	c := cachify.NewClockCallback(3, func(key string, value interface{}) {
+		evicted = append(evicted, key)
+	})
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Set("c", 3)
+	value, ok := c.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, value)
+
+	c.Set("d", 4)
+	assert.Equal(t, []string{"b"}, evicted)
+	assert.True(t, c.Contains("a"))
+	assert.Equal(t, 3, c.Len())
+
+	c.Remove("c")
+	c.Set("e", 5)
+	assert.Equal(t, []string{"b"}, evicted)
+	assert.ElementsMatch(t, []string{"a", "d", "e"}, c.Keys())
+}
+
+// Test concurrent CLOCK reads and writes
+func TestClock_Concurrent(t *testing.T) {
+	c := cachify.NewClock(50)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 500; j++ {
+				key := string(rune('a' + (i*j)%80))
+				c.Set(key, j)
+				c.Get(key)
+			}
+		}(i)
+	}
+	wg.Wait()
+	assert.LessOrEqual(t, c.Len(), 50)
+}
@@ -0,0 +1,57 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+type cloneProfile struct {
+	Name  string
+	Roles []string
+}
+
+// Test Clone copies contents and configuration independently
+func TestLRU_Clone(t *testing.T) {
+	source := cachify.NewLRU(2)
+	source.SetExpiry(time.Hour)
+	defer source.Close()
+	source.Set("a", "alpha")
+	source.SetWithTags("b", &cloneProfile{Name: "b", Roles: []string{"admin"}}, "profiles")
+
+	clone := source.Clone(false)
+	defer clone.Close()
+	assert.Equal(t, source.Keys(), clone.Keys())
+	assert.Equal(t, 2, clone.Capacity())
+
+	clone.Set("c", "gamma")
+	assert.False(t, source.Contains("c"))
+	assert.True(t, source.Contains("a"))
+	assert.False(t, clone.Contains("a"))
+
+	shared, _ := clone.Get("b")
+	original, _ := source.Get("b")
+	assert.Same(t, original, shared)
+	assert.Equal(t, 1, clone.InvalidateTag("profiles"))
+	assert.True(t, source.Contains("b"))
+}
+
+// Test deep clones do not share mutable values
+func TestLRU_CloneDeep(t *testing.T) {
+	source := cachify.NewLRU(2)
+	source.Set("p", &cloneProfile{Name: "p", Roles: []string{"admin"}})
+	source.Set("m", map[string][]int{"x": {1, 2}})
+
+	clone := source.Clone(true)
+	p, _ := clone.Get("p")
+	p.(*cloneProfile).Roles[0] = "guest"
+	m, _ := clone.Get("m")
+	m.(map[string][]int)["x"][0] = 9
+
+	original, _ := source.Get("p")
+	assert.Equal(t, "admin", original.(*cloneProfile).Roles[0])
+	originalMap, _ := source.Get("m")
+	assert.Equal(t, 1, originalMap.(map[string][]int)["x"][0])
+}
@@ -0,0 +1,66 @@
+package test
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// closeCounter is an io.Closer counting its Close calls.
+type closeCounter struct {
+	closed atomic.Int32
+	err    error
+}
+
+func (c *closeCounter) Close() error {
+	c.closed.Add(1)
+	return c.err
+}
+
+// releaseCounter is a Releaser counting its Release calls.
+type releaseCounter struct {
+	released atomic.Int32
+}
+
+func (r *releaseCounter) Release() {
+	r.released.Add(1)
+}
+
+// Test WithAutoClose releases values on eviction, replacement, and removal, but not on re-set
+func TestLRU_AutoClose(t *testing.T) {
+	failure := errors.New("close failed")
+	var reported atomic.Value
+	cache := cachify.NewLRU(1).WithAutoClose(func(key string, err error) {
+		reported.Store(err)
+	})
+	assert.True(t, cache.IsAutoClose())
+
+	evicted := &closeCounter{err: failure}
+	cache.Set("a", evicted)
+	cache.Set("b", "plain")
+	assert.Eventually(t, func() bool { return evicted.closed.Load() == 1 }, time.Second, time.Millisecond)
+	assert.Eventually(t, func() bool { return reported.Load() == failure }, time.Second, time.Millisecond)
+
+	replaced := &releaseCounter{}
+	cache.Set("b", replaced)
+	cache.Set("b", replaced)
+	cache.Set("b", "other")
+	assert.Eventually(t, func() bool { return replaced.released.Load() == 1 }, time.Second, time.Millisecond)
+
+	removed := &closeCounter{}
+	cache.Set("b", removed)
+	cache.Remove("b")
+	assert.Eventually(t, func() bool { return removed.closed.Load() == 1 }, time.Second, time.Millisecond)
+
+	kept := &closeCounter{}
+	cache.WithoutAutoClose()
+	cache.Set("c", kept)
+	cache.Clear()
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, int32(0), kept.closed.Load())
+	assert.Equal(t, int32(1), replaced.released.Load())
+}
@@ -0,0 +1,58 @@
+package test
+
+import (
+	"encoding/gob"
+	"path/filepath"
+	"testing"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+type codecPayload struct {
+	Name  string
+	Count int
+}
+
+// Test JSON round trip into a generic value
+func TestCodec_JSON(t *testing.T) {
+	codec := cachify.JSONCodec{}
+	data, err := codec.Marshal(map[string]interface{}{"name": "alpha"})
+	assert.NoError(t, err)
+
+	var value interface{}
+	assert.NoError(t, codec.Unmarshal(data, &value))
+	assert.Equal(t, map[string]interface{}{"name": "alpha"}, value)
+}
+
+// Test gob round trip preserves registered concrete types
+func TestCodec_Gob(t *testing.T) {
+	gob.Register(codecPayload{})
+	codec := cachify.GobCodec{}
+	data, err := codec.Marshal(codecPayload{Name: "alpha", Count: 2})
+	assert.NoError(t, err)
+
+	var value interface{}
+	assert.NoError(t, codec.Unmarshal(data, &value))
+	assert.Equal(t, codecPayload{Name: "alpha", Count: 2}, value)
+}
+
+// Test snapshot files use the cache codec and custom codecs can be registered
+func TestLRU_FileCodec(t *testing.T) {
+	codec, ok := cachify.LookupCodec("json")
+	assert.True(t, ok)
+	cachify.RegisterCodec("upper-json", codec)
+	_, ok = cachify.LookupCodec("upper-json")
+	assert.True(t, ok)
+
+	path := filepath.Join(t.TempDir(), "cache.json.snap")
+	c := cachify.NewLRU(10).WithCodec(codec)
+	c.Set("n", 1)
+	assert.NoError(t, c.SaveToFile(path))
+
+	restored := cachify.NewLRU(10).WithCodec(cachify.JSONCodec{})
+	_, err := restored.LoadFromFile(path)
+	assert.NoError(t, err)
+	n, _ := restored.Get("n")
+	assert.Equal(t, float64(1), n)
+}
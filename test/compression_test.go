@@ -0,0 +1,37 @@
+package test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test compressed values round-trip through every read path
+func TestLRU_WithCompression(t *testing.T) {
+	for _, compressor := range []cachify.Compressor{cachify.GzipCompressor{}, cachify.SnappyCompressor{}} {
+		c := cachify.NewLRU(10).WithCompression(compressor, 64)
+		html := strings.Repeat("<div>fragment</div>", 500)
+		blob := []byte(strings.Repeat("x", 1000))
+		c.Set("html", html)
+		c.Set("blob", blob)
+		c.Set("small", "tiny")
+
+		value, ok := c.Get("html")
+		assert.True(t, ok)
+		assert.Equal(t, html, value)
+		value, _ = c.Get("blob")
+		assert.Equal(t, blob, value)
+		value, _ = c.Get("small")
+		assert.Equal(t, "tiny", value)
+		assert.Equal(t, html, c.GetAll()["html"])
+		assert.True(t, c.CompareAndSwap("html", html, "replaced"))
+
+		var evicted interface{}
+		c.SetCallback(func(key string, value interface{}) { evicted = value })
+		c.Set("html", html)
+		c.SetCapacity(2)
+		assert.Equal(t, blob, evicted)
+	}
+}
@@ -0,0 +1,46 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test the capacity controller grows a full cache with a low hit ratio, within bounds
+func TestCapacityController_Grow(t *testing.T) {
+	cache := cachify.NewLRU(10)
+	controller := cachify.NewCapacityController(cache, 10, 12, time.Hour)
+	defer controller.Close()
+
+	for i := 0; i < 20; i++ {
+		cache.Set(fmt.Sprintf("k%d", i), i)
+		cache.Get(fmt.Sprintf("miss%d", i))
+	}
+	assert.Equal(t, 11, controller.Adjust())
+	assert.Equal(t, 11, cache.Capacity())
+
+	// No lookups since the previous adjustment: nothing to measure
+	assert.Equal(t, 11, controller.Adjust())
+
+	cache.Get("miss")
+	cache.Set("k-new", 1)
+	assert.Equal(t, 12, controller.Adjust())
+	cache.Get("miss")
+	assert.Equal(t, 12, controller.Adjust())
+}
+
+// Test the capacity controller shrinks under memory pressure, down to the minimum
+func TestCapacityController_Shrink(t *testing.T) {
+	cache := cachify.NewLRU(0)
+	for i := 0; i < 30; i++ {
+		cache.Set(fmt.Sprintf("k%d", i), i)
+	}
+	controller := cachify.NewCapacityController(cache, 18, 20, 5*time.Millisecond).WithMemoryLimit(1)
+	assert.Eventually(t, func() bool { return cache.Capacity() == 18 }, time.Second, 5*time.Millisecond)
+	controller.Close()
+	assert.Equal(t, 18, cache.Len())
+	controller.Close()
+}
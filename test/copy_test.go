@@ -0,0 +1,31 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test WithCopier isolates stored values from writers and readers
+func TestLRU_WithCopier(t *testing.T) {
+	cache := cachify.NewLRU(10).WithCopier(cachify.DeepCopy)
+
+	written := map[string]int{"n": 1}
+	cache.Set("a", written)
+	written["n"] = 2
+
+	read, _ := cache.Get("a")
+	assert.Equal(t, map[string]int{"n": 1}, read)
+	read.(map[string]int)["n"] = 3
+
+	again, _ := cache.Get("a")
+	assert.Equal(t, map[string]int{"n": 1}, again)
+	assert.Equal(t, map[string]int{"n": 1}, cache.Snapshot()[0].Value)
+
+	cache.WithCopier(nil)
+	shared, _ := cache.Get("a")
+	shared.(map[string]int)["n"] = 4
+	again, _ = cache.Get("a")
+	assert.Equal(t, map[string]int{"n": 4}, again)
+}
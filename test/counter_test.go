@@ -0,0 +1,63 @@
+package test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test Increment creates, bumps and rejects non-numeric values
+func TestLRU_Increment(t *testing.T) {
+	cache := cachify.NewLRU(5)
+
+	n, err := cache.Increment("hits", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+
+	cache.Set("legacy", 41)
+	n, err = cache.Increment("legacy", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(42), n)
+
+	n, err = cache.Decrement("legacy", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(40), n)
+
+	cache.Set("name", "alpha")
+	_, err = cache.Increment("name", 1)
+	assert.ErrorIs(t, err, cachify.ErrNotNumeric)
+
+	f, err := cache.IncrementFloat("legacy", 0.5)
+	assert.NoError(t, err)
+	assert.Equal(t, 40.5, f)
+}
+
+// Test creating a counter reports a rejected value
+func TestLRU_Increment_Rejected(t *testing.T) {
+	cache := cachify.NewLRU(5).WithSizer(func(value interface{}) int { return 16 }).WithMaxValueSize(8)
+
+	_, err := cache.Increment("hits", 1)
+	assert.ErrorIs(t, err, cachify.ErrTooLarge)
+	_, err = cache.IncrementFloat("ratio", 0.5)
+	assert.ErrorIs(t, err, cachify.ErrTooLarge)
+	assert.Equal(t, 0, cache.Len())
+}
+
+// Test concurrent increments are atomic
+func TestLRU_IncrementConcurrent(t *testing.T) {
+	cache := cachify.NewLRU(5)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = cache.Increment("counter", 2)
+		}()
+	}
+	wg.Wait()
+	val, _ := cache.Get("counter")
+	assert.Equal(t, int64(100), val)
+}
@@ -0,0 +1,39 @@
+package test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test WithDeduplication shares identical serialized values and releases them with their last key
+func TestLRU_WithDeduplication(t *testing.T) {
+	cache := cachify.NewLRU(10).WithSerialization(true).WithDeduplication(true)
+	payload := strings.Repeat("x", 100)
+
+	cache.Set("a", payload)
+	cache.Set("b", payload)
+	cache.Set("c", payload)
+	cache.Set("d", "other")
+
+	unique, saved := cache.Deduplicated()
+	assert.Equal(t, 2, unique)
+	assert.Greater(t, saved, 200)
+
+	value, ok := cache.Get("b")
+	assert.True(t, ok)
+	assert.Equal(t, payload, value)
+
+	cache.Set("a", "changed")
+	cache.Remove("b")
+	_, saved = cache.Deduplicated()
+	assert.Equal(t, 0, saved)
+
+	cache.Remove("c")
+	cache.Remove("d")
+	cache.Remove("a")
+	unique, _ = cache.Deduplicated()
+	assert.Equal(t, 0, unique)
+}
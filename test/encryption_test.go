@@ -0,0 +1,53 @@
+package test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test encrypted snapshot files hide their content and require the key
+func TestLRU_WithEncryption(t *testing.T) {
+	aead, err := cachify.NewAESGCM(bytes.Repeat([]byte{7}, 32))
+	assert.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "cache.snap")
+
+	c := cachify.NewLRU(10).WithEncryption(aead)
+	c.Set("user:42:email", "jane@example.com")
+	assert.NoError(t, c.SaveToFile(path))
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), "jane@example.com")
+	assert.NotContains(t, string(data), "user:42")
+
+	restored := cachify.NewLRU(10).WithEncryption(aead)
+	n, err := restored.LoadFromFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	other, _ := cachify.NewAESGCM(bytes.Repeat([]byte{8}, 32))
+	_, err = cachify.NewLRU(10).WithEncryption(other).LoadFromFile(path)
+	assert.Error(t, err)
+	_, err = cachify.NewAESGCM([]byte("short"))
+	assert.Error(t, err)
+}
+
+// Test EncryptedCodec seals store values
+func TestEncryptedCodec(t *testing.T) {
+	aead, _ := cachify.NewAESGCM(bytes.Repeat([]byte{1}, 16))
+	codec := cachify.NewEncryptedCodec(cachify.JSONCodec{}, aead)
+	data, err := codec.Marshal("secret")
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), "secret")
+
+	var value interface{}
+	assert.NoError(t, codec.Unmarshal(data, &value))
+	assert.Equal(t, "secret", value)
+
+	data[len(data)-1] ^= 0xff
+	assert.Error(t, codec.Unmarshal(data, &value))
+}
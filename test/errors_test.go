@@ -0,0 +1,59 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test error-returning variants report miss causes
+func TestLRU_ErrorVariants(t *testing.T) {
+	cache := cachify.NewLRU(2)
+	cache.SetExpiry(time.Minute)
+
+	_, err := cache.GetE("missing")
+	assert.ErrorIs(t, err, cachify.ErrNotFound)
+
+	assert.NoError(t, cache.SetE("a", "alpha"))
+	val, err := cache.GetE("a")
+	assert.NoError(t, err)
+	assert.Equal(t, "alpha", val)
+
+	cache.ExpandExpiry("a", -2*time.Minute)
+	_, err = cache.GetE("a")
+	assert.ErrorIs(t, err, cachify.ErrExpired)
+	assert.False(t, cache.Contains("a"))
+
+	assert.ErrorIs(t, cache.UpdateE("a", "x"), cachify.ErrNotFound)
+	assert.ErrorIs(t, cache.RemoveE("a"), cachify.ErrNotFound)
+
+	cache.Close()
+	assert.True(t, cache.IsClosed())
+	assert.ErrorIs(t, cache.SetE("b", "beta"), cachify.ErrClosed)
+	_, err = cache.GetE("b")
+	assert.ErrorIs(t, err, cachify.ErrClosed)
+	cache.Close()
+}
+
+// Test GetE records latency and serves hits through the buffered read path like Get
+func TestLRU_GetE_LikeGet(t *testing.T) {
+	cache := cachify.NewLRU(3).WithLatencyTracking(true).WithBufferedAccess(2)
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+	cache.Set("c", 3)
+
+	value, err := cache.GetE("a")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, value)
+	// The access is buffered, so "a" has not moved yet
+	assert.Equal(t, []string{"c", "b", "a"}, cache.Keys())
+
+	_, err = cache.GetE("missing")
+	assert.ErrorIs(t, err, cachify.ErrNotFound)
+	stats := cache.Stats()
+	assert.Equal(t, uint64(1), stats.Hits)
+	assert.Equal(t, uint64(1), stats.Misses)
+	assert.Equal(t, uint64(2), stats.Latency.Get.Count)
+}
@@ -0,0 +1,132 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test the background cleanup never removes live entries
+func TestExpiry_CleanupKeepsLiveEntries(t *testing.T) {
+	cache := cachify.NewLRUExpires(10, time.Hour).WithCleanupInterval(5 * time.Millisecond)
+	defer cache.Close()
+
+	cache.Set("live", 1)
+	cache.Set("dead", 2)
+	cache.ExpandExpiry("dead", -2*time.Hour)
+
+	assert.Eventually(t, func() bool {
+		return !cache.Contains("dead")
+	}, time.Second, 5*time.Millisecond)
+	time.Sleep(20 * time.Millisecond)
+	assert.True(t, cache.Contains("live"))
+}
+
+// Test every read and inspection path agrees on what is expired
+func TestExpiry_Consistency(t *testing.T) {
+	cache := cachify.NewLRUExpiresLazy(10, time.Hour)
+
+	cache.Set("live", 1)
+	cache.Set("dead", 2)
+	cache.ExpandExpiry("dead", -2*time.Hour)
+
+	assert.False(t, cache.IsExpired("live"))
+	assert.True(t, cache.IsExpired("dead"))
+
+	_, ok := cache.Get("live")
+	assert.True(t, ok)
+	_, ok = cache.Get("dead")
+	assert.False(t, ok)
+
+	cache.Set("dead", 2)
+	cache.ExpandExpiry("dead", -2*time.Hour)
+	_, err := cache.GetE("dead")
+	assert.ErrorIs(t, err, cachify.ErrExpired)
+
+	cache.Set("dead", 2)
+	cache.ExpandExpiry("dead", -2*time.Hour)
+	assert.True(t, cache.Add("dead", 3))
+	assert.Equal(t, 0, cache.PurgeExpired())
+}
+
+// Test entries written without an expiration never expire
+func TestExpiry_ZeroExpirationNeverExpires(t *testing.T) {
+	cache := cachify.NewLRU(10)
+
+	cache.Set("forever", 1)
+	cache.SetExpiry(time.Hour)
+	cache.Set("ttl", 2)
+
+	assert.False(t, cache.IsExpired("forever"))
+	_, ok := cache.Get("forever")
+	assert.True(t, ok)
+	_, ok = cache.PersistExpiry("forever")
+	assert.False(t, ok)
+	remain, ok := cache.PersistExpiry("ttl")
+	assert.True(t, ok)
+	assert.Greater(t, remain, 59*time.Minute)
+	assert.Equal(t, 0, cache.PurgeExpired())
+}
+
+// Test SetExpiry manages the cleanup lifecycle
+func TestExpiry_Lifecycle(t *testing.T) {
+	cache := cachify.NewLRU(10)
+	assert.NotPanics(t, cache.DestroyCleanup)
+
+	cache.SetExpiry(time.Hour)
+	cache.WithCleanupInterval(5 * time.Millisecond)
+	cache.Set("dead", 1)
+	cache.ExpandExpiry("dead", -2*time.Hour)
+	assert.Eventually(t, func() bool {
+		return !cache.Contains("dead")
+	}, time.Second, 5*time.Millisecond)
+
+	cache.SetExpiry(0)
+	cache.SetExpiry(time.Hour)
+	cache.DestroyCleanup()
+	assert.NotPanics(t, cache.DestroyCleanup)
+	cache.Set("dead", 1)
+	cache.ExpandExpiry("dead", -2*time.Hour)
+	time.Sleep(20 * time.Millisecond)
+	assert.True(t, cache.Contains("dead"))
+
+	cache.Close()
+	assert.NotPanics(t, cache.DestroyCleanup)
+}
+
+// Test OnExpire and per-key expiration callbacks fire only for expirations
+func TestExpiry_OnExpire(t *testing.T) {
+	var evicted, expiredKeys, refreshed []string
+	cache := cachify.NewLRUExpiresLazy(2, time.Hour).OnExpire(func(key string, value interface{}) {
+		expiredKeys = append(expiredKeys, key)
+	})
+	cache.SetCallback(func(key string, value interface{}) {
+		evicted = append(evicted, key)
+	})
+
+	cache.SetWithExpireCallback("a", 1, func(key string, value interface{}) {
+		refreshed = append(refreshed, key)
+	})
+	cache.Set("b", 2)
+	cache.Set("c", 3)
+	assert.Equal(t, []string{"a"}, evicted)
+	assert.Empty(t, expiredKeys)
+	assert.Empty(t, refreshed)
+
+	cache.SetWithExpireCallback("d", 4, func(key string, value interface{}) {
+		refreshed = append(refreshed, key)
+		assert.Equal(t, 4, value)
+	})
+	cache.Set("d", 4)
+	cache.ExpandExpiry("d", -2*time.Hour)
+	_, ok := cache.Get("d")
+	assert.False(t, ok)
+	assert.Equal(t, []string{"d"}, expiredKeys)
+	assert.Equal(t, []string{"d"}, refreshed)
+	assert.Equal(t, []string{"a", "b", "d"}, evicted)
+
+	cache.Remove("c")
+	assert.Equal(t, []string{"d"}, expiredKeys)
+}
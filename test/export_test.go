@@ -0,0 +1,107 @@
+package test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test every change is reported with its operation
+func TestLRU_WithEventListener(t *testing.T) {
+	var ops []string
+	cache := cachify.NewLRU(2).WithEventListener(func(event cachify.Event) {
+		ops = append(ops, event.Op.String()+":"+event.Key)
+	})
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+	cache.Set("c", 3)
+	cache.Update("b", 20)
+	cache.Remove("b")
+	cache.Clear()
+	assert.Equal(t, []string{"set:a", "set:b", "set:c", "evict:a", "set:b", "remove:b", "clear:"}, ops)
+}
+
+// Test the exporter writes one JSON record per event
+func TestExporter(t *testing.T) {
+	var buf bytes.Buffer
+	var failed []string
+	exporter := cachify.NewExporter(&buf, 0).WithErrorCallback(func(key string, err error) {
+		failed = append(failed, key)
+	})
+	cache := cachify.NewLRU(10).WithEventListener(exporter.Handle)
+	cache.Set("user:1", map[string]string{"name": "Ada"})
+	cache.Set("bad", make(chan int))
+	cache.Remove("user:1")
+	assert.Nil(t, exporter.Close())
+	exporter.Handle(cachify.Event{Key: "late"})
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Len(t, lines, 2)
+	var event cachify.Event
+	assert.Nil(t, json.Unmarshal([]byte(lines[0]), &event))
+	assert.Equal(t, cachify.EventSet, event.Op)
+	assert.Equal(t, "user:1", event.Key)
+	assert.Equal(t, map[string]interface{}{"name": "Ada"}, event.Value)
+	assert.Contains(t, lines[1], `"op":"remove"`)
+	assert.Equal(t, []string{"bad"}, failed)
+}
+
+// blockingWriter fails every write, blocking the first one until release is closed.
+type blockingWriter struct {
+	started chan struct{}
+	release chan struct{}
+	writes  int
+}
+
+func (w *blockingWriter) Write(p []byte) (int, error) {
+	w.writes++
+	if w.writes == 1 {
+		close(w.started)
+		<-w.release
+	}
+	return 0, errors.New("write failed")
+}
+
+// Test a full buffer drops events instead of blocking and Close still drains with a failing writer
+func TestExporter_FullBuffer_Close(t *testing.T) {
+	writer := &blockingWriter{started: make(chan struct{}), release: make(chan struct{})}
+	var failed atomic.Int32
+	exporter := cachify.NewExporter(writer, 1).WithErrorCallback(func(key string, err error) {
+		failed.Add(1)
+	})
+	cache := cachify.NewLRU(10).WithEventListener(exporter.Handle)
+	cache.Set("a", 1)
+	<-writer.started
+	cache.Set("b", 2)
+	cache.Set("c", 3)
+	assert.Equal(t, uint64(1), exporter.Dropped())
+
+	closed := make(chan error)
+	go func() { closed <- exporter.Close() }()
+	close(writer.release)
+	select {
+	case err := <-closed:
+		assert.Nil(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("Close did not return")
+	}
+	assert.Equal(t, int32(2), failed.Load())
+}
+
+// Test the record captures the value at the time of the change
+func TestExporter_EncodesOnHandle(t *testing.T) {
+	var buf bytes.Buffer
+	exporter := cachify.NewExporter(&buf, 0)
+	value := map[string]int{"n": 1}
+	exporter.Handle(cachify.Event{Op: cachify.EventSet, Key: "k", Value: value})
+	value["n"] = 2
+	assert.Nil(t, exporter.Close())
+	assert.Contains(t, buf.String(), `"value":{"n":1}`)
+}
@@ -0,0 +1,97 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/pnguyen215/cachify/remote"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test each key is loaded once across the cluster, by the instance owning it
+func TestLoading_WithOwners(t *testing.T) {
+	var loads [2]atomic.Int32
+	instances := make([]*cachify.Loading, 2)
+	servers := make([]*httptest.Server, 2)
+	for i := range servers {
+		i := i
+		servers[i] = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			remote.NewFillHandler(instances[i]).ServeHTTP(w, r)
+		}))
+		defer servers[i].Close()
+	}
+	ring := cachify.NewStoreRing(0)
+	for _, server := range servers {
+		ring.Add(server.URL, remote.NewPeer(server.URL))
+	}
+	for i := range instances {
+		i := i
+		instances[i] = cachify.NewLoading(cachify.NewLRU(100), func(ctx context.Context, key string) (interface{}, error) {
+			loads[i].Add(1)
+			return "value-of-" + key, nil
+		}).WithOwners(servers[i].URL, ring)
+	}
+
+	ctx := context.Background()
+	for i := 0; i < 20; i++ {
+		key := fmt.Sprintf("k%d", i)
+		for _, instance := range instances {
+			value, err := instance.Get(ctx, key)
+			assert.Nil(t, err)
+			assert.Equal(t, "value-of-"+key, value)
+		}
+	}
+	assert.Equal(t, int32(20), loads[0].Load()+loads[1].Load())
+	assert.Greater(t, loads[0].Load(), int32(0))
+	assert.Greater(t, loads[1].Load(), int32(0))
+}
+
+// Test a key is loaded locally when its owner fails
+func TestLoading_WithOwners_Fallback(t *testing.T) {
+	owner := cachify.NewLoading(cachify.NewLRU(10), func(ctx context.Context, key string) (interface{}, error) {
+		return nil, errors.New("backend down")
+	})
+	server := httptest.NewServer(remote.NewFillHandler(owner))
+	defer server.Close()
+
+	ring := cachify.NewStoreRing(0)
+	ring.Add("owner", remote.NewPeer(server.URL))
+	local := cachify.NewLoading(cachify.NewLRU(10), func(ctx context.Context, key string) (interface{}, error) {
+		return "local", nil
+	}).WithOwners("self", ring)
+
+	value, err := local.Get(context.Background(), "a")
+	assert.Nil(t, err)
+	assert.Equal(t, "local", value)
+}
+
+// Test a key the owner reports absent is not loaded locally
+func TestLoading_WithOwners_Absent(t *testing.T) {
+	var ownerLoads, localLoads atomic.Int32
+	owner := cachify.NewLoading(cachify.NewLRU(10), func(ctx context.Context, key string) (interface{}, error) {
+		ownerLoads.Add(1)
+		return nil, cachify.ErrNotFound
+	}).WithNegativeFilter(100, 0.01, 0)
+	server := httptest.NewServer(remote.NewFillHandler(owner))
+	defer server.Close()
+
+	ring := cachify.NewStoreRing(0)
+	ring.Add("owner", remote.NewPeer(server.URL))
+	local := cachify.NewLoading(cachify.NewLRU(10), func(ctx context.Context, key string) (interface{}, error) {
+		localLoads.Add(1)
+		return "local", nil
+	}).WithOwners("self", ring)
+
+	for i := 0; i < 3; i++ {
+		_, err := local.Get(context.Background(), "missing")
+		assert.ErrorIs(t, err, cachify.ErrNotFound)
+	}
+	assert.Equal(t, int32(0), localLoads.Load())
+	assert.Equal(t, int32(1), ownerLoads.Load())
+}
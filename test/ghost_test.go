@@ -0,0 +1,71 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test ghost hits count writes to recently evicted keys
+func TestLRU_GhostHits(t *testing.T) {
+	c := cachify.NewLRU(2).WithGhostList(10)
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Set("c", 3)
+	assert.Equal(t, 1, c.Ghosts())
+
+	c.Set("a", 1)
+	assert.Equal(t, uint64(1), c.GhostHits())
+	c.Set("z", 1)
+	assert.Equal(t, uint64(1), c.GhostHits())
+	assert.Equal(t, uint64(0), cachify.NewLRU(2).GhostHits())
+}
+
+// Test adaptive capacity grows under ghost hits and shrinks without them
+func TestLRU_AdaptiveCapacity(t *testing.T) {
+	c := cachify.NewLRU(10).WithAdaptiveCapacity(5, 20)
+	for round := 0; round < 10; round++ {
+		for i := 0; i < 15; i++ {
+			c.Set(fmt.Sprintf("k%d", i), i)
+		}
+	}
+	assert.Greater(t, c.Capacity(), 10)
+	assert.LessOrEqual(t, c.Capacity(), 20)
+
+	for i := 0; i < 500; i++ {
+		c.Set(fmt.Sprintf("once%d", i), i)
+	}
+	assert.Equal(t, 5, c.Capacity())
+}
+
+// Test adaptive capacity leaves an unbounded cache unbounded
+func TestLRU_AdaptiveCapacity_Unbounded(t *testing.T) {
+	c := cachify.NewLRU(0).WithAdaptiveCapacity(5, 20)
+	for i := 0; i < 100; i++ {
+		c.Set(fmt.Sprintf("k%d", i), i)
+	}
+	assert.Equal(t, 0, c.Capacity())
+	assert.Equal(t, 100, c.Len())
+}
+
+// Test adaptive capacity clamps a starting capacity outside the bounds
+func TestLRU_AdaptiveCapacity_Clamp(t *testing.T) {
+	c := cachify.NewLRU(100)
+	for i := 0; i < 50; i++ {
+		c.Set(fmt.Sprintf("k%d", i), i)
+	}
+	c.WithAdaptiveCapacity(5, 20)
+	assert.Equal(t, 20, c.Capacity())
+	assert.Equal(t, 20, c.Len())
+
+	for round := 0; round < 10; round++ {
+		for i := 0; i < 30; i++ {
+			c.Set(fmt.Sprintf("k%d", i), i)
+		}
+	}
+	assert.LessOrEqual(t, c.Capacity(), 20)
+
+	assert.Equal(t, 5, cachify.NewLRU(2).WithAdaptiveCapacity(5, 20).Capacity())
+}
@@ -0,0 +1,84 @@
+package test
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/pnguyen215/cachify/gossip"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test invalidations gossip across peers that only know a seed
+func TestGossip_Broadcaster(t *testing.T) {
+	seed, err := gossip.NewBroadcaster("127.0.0.1:0")
+	assert.Nil(t, err)
+	defer seed.Close()
+	second, err := gossip.NewBroadcaster("127.0.0.1:0", seed.Addr().String())
+	assert.Nil(t, err)
+	defer second.Close()
+	third, err := gossip.NewBroadcaster("127.0.0.1:0", seed.Addr().String())
+	assert.Nil(t, err)
+	defer third.Close()
+
+	caches := make([]*cachify.Invalidator, 0, 3)
+	for _, b := range []*gossip.Broadcaster{seed, second, third} {
+		invalidator, err := cachify.NewInvalidator(cachify.NewLRU(10), b)
+		assert.Nil(t, err)
+		invalidator.Cache().Set("user:1", "stale")
+		caches = append(caches, invalidator)
+	}
+
+	// Announce the joiners to the seed so it can forward to them
+	assert.Nil(t, second.Publish(context.Background(), cachify.Invalidation{Origin: "hello"}))
+	assert.Nil(t, third.Publish(context.Background(), cachify.Invalidation{Origin: "hello"}))
+	assert.Eventually(t, func() bool { return len(seed.Peers()) == 2 }, time.Second, 10*time.Millisecond)
+
+	caches[1].Set("user:1", "fresh")
+	assert.Eventually(t, func() bool {
+		return !caches[0].Cache().Contains("user:1") && !caches[2].Cache().Contains("user:1")
+	}, time.Second, 10*time.Millisecond)
+	value, ok := caches[1].Get("user:1")
+	assert.True(t, ok)
+	assert.Equal(t, "fresh", value)
+
+	assert.Nil(t, third.Close())
+	assert.ErrorIs(t, third.Publish(context.Background(), cachify.Invalidation{}), gossip.ErrClosed)
+}
+
+// Test incoming messages cannot ask for more forwards than the receiver allows
+func TestGossip_Broadcaster_HopsCapped(t *testing.T) {
+	downstream, err := gossip.NewBroadcaster("127.0.0.1:0")
+	assert.Nil(t, err)
+	defer downstream.Close()
+	var received atomic.Int32
+	assert.Nil(t, downstream.Subscribe(context.Background(), func(msg cachify.Invalidation) {
+		received.Add(1)
+	}))
+	relay, err := gossip.NewBroadcaster("127.0.0.1:0", downstream.Addr().String())
+	assert.Nil(t, err)
+	defer relay.Close()
+	relay.WithHops(0)
+	delivered := make(chan struct{}, 1)
+	assert.Nil(t, relay.Subscribe(context.Background(), func(msg cachify.Invalidation) {
+		delivered <- struct{}{}
+	}))
+
+	sender, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	assert.Nil(t, err)
+	defer sender.Close()
+	_, err = sender.WriteToUDP([]byte(`{"id":"flood","hops":100,"msg":{"origin":"x","keys":["k"]}}`), relay.Addr().(*net.UDPAddr))
+	assert.Nil(t, err)
+
+	select {
+	case <-delivered:
+	case <-time.After(time.Second):
+		t.Fatal("message not delivered")
+	}
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, int32(0), received.Load())
+	assert.Contains(t, relay.Peers(), sender.LocalAddr().String())
+}
@@ -0,0 +1,76 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+type session struct {
+	User string
+}
+
+// Test GetByIndex follows writes, removals, and evictions
+func TestLRU_GetByIndex(t *testing.T) {
+	cache := cachify.NewLRU(3)
+	cache.Set("s0", session{User: "alice"})
+	cache.WithIndex(func(value interface{}) []string {
+		if s, ok := value.(session); ok {
+			return []string{s.User}
+		}
+		return nil
+	})
+
+	cache.Set("s1", session{User: "alice"})
+	cache.Set("s2", session{User: "bob"})
+	assert.Equal(t, map[string]interface{}{
+		"s0": session{User: "alice"},
+		"s1": session{User: "alice"},
+	}, cache.GetByIndex("alice"))
+
+	// Overwriting moves the entry to its new index value
+	cache.Set("s1", session{User: "bob"})
+	assert.Len(t, cache.GetByIndex("alice"), 1)
+	assert.Len(t, cache.GetByIndex("bob"), 2)
+
+	cache.Remove("s2")
+	assert.Len(t, cache.GetByIndex("bob"), 1)
+
+	// Evicting s0 (the least recently used) drops it from the index
+	cache.Set("s3", "not a session")
+	cache.Set("s4", session{User: "carol"})
+	assert.Empty(t, cache.GetByIndex("alice"))
+	assert.Len(t, cache.GetByIndex("carol"), 1)
+
+	cache.Clear()
+	assert.Empty(t, cache.GetByIndex("carol"))
+}
+
+// Test FindByValueHash returns the keys storing identical values
+func TestLRU_FindByValueHash(t *testing.T) {
+	cache := cachify.NewLRU(10)
+	cache.Set("a", "payload")
+	cache.WithValueHashIndex(true)
+	cache.Set("b", "payload")
+	cache.Set("c", session{User: "alice"})
+	cache.Set("d", session{User: "alice"})
+	cache.Set("e", "other")
+
+	hash, err := cache.HashValue("payload")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, cache.FindByValueHash(hash))
+
+	hash, ok := cache.ValueHash("c")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"c", "d"}, cache.FindByValueHash(hash))
+
+	cache.Set("d", "payload")
+	cache.Remove("a")
+	assert.Equal(t, []string{"c"}, cache.FindByValueHash(hash))
+	hash, _ = cache.HashValue("payload")
+	assert.Equal(t, []string{"b", "d"}, cache.FindByValueHash(hash))
+
+	cache.WithValueHashIndex(false)
+	assert.Nil(t, cache.FindByValueHash(hash))
+}
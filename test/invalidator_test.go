@@ -0,0 +1,59 @@
+package test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// localBroadcaster delivers invalidations synchronously to every in-process subscriber.
+type localBroadcaster struct {
+	mutex    sync.Mutex
+	handlers []func(msg cachify.Invalidation)
+}
+
+func (b *localBroadcaster) Publish(_ context.Context, msg cachify.Invalidation) error {
+	b.mutex.Lock()
+	handlers := append([]func(msg cachify.Invalidation){}, b.handlers...)
+	b.mutex.Unlock()
+	for _, handler := range handlers {
+		handler(msg)
+	}
+	return nil
+}
+
+func (b *localBroadcaster) Subscribe(_ context.Context, handler func(msg cachify.Invalidation)) error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.handlers = append(b.handlers, handler)
+	return nil
+}
+
+func (b *localBroadcaster) Close() error {
+	return nil
+}
+
+// Test local writes drop peer copies but keep the local value
+func TestInvalidator_Peers(t *testing.T) {
+	bus := &localBroadcaster{}
+	a, err := cachify.NewInvalidator(cachify.NewLRU(4), bus)
+	assert.NoError(t, err)
+	b, err := cachify.NewInvalidator(cachify.NewLRU(4), bus)
+	assert.NoError(t, err)
+
+	a.Cache().Set("k", "stale")
+	b.Set("k", "fresh")
+	assert.False(t, a.Cache().Contains("k"))
+	val, ok := b.Get("k")
+	assert.True(t, ok)
+	assert.Equal(t, "fresh", val)
+
+	a.Set("x", 1)
+	b.Cache().Set("y", 2)
+	a.Invalidate("y")
+	assert.True(t, a.Cache().Contains("x"))
+	assert.False(t, b.Cache().Contains("y"))
+}
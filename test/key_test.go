@@ -0,0 +1,24 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test Key joins parts and escapes collisions
+func TestKey(t *testing.T) {
+	assert.Equal(t, "user:42:profile", cachify.Key("user", 42, "profile"))
+	assert.Equal(t, "flag:true:1.5:7", cachify.Key("flag", true, 1.5, uint64(7)))
+	assert.NotEqual(t, cachify.Key("a:b", "c"), cachify.Key("a", "b:c"))
+	assert.Equal(t, `a\:b:c\\`, cachify.Key("a:b", `c\`))
+	assert.Equal(t, "", cachify.Key())
+	assert.Equal(t, cachify.Key("1", 1), cachify.Key(1, "1"))
+	assert.NotEqual(t, cachify.Key("a", ""), cachify.Key("a"))
+
+	allocs := testing.AllocsPerRun(100, func() {
+		cachify.Key("user", 42, "profile")
+	})
+	assert.LessOrEqual(t, allocs, 2.0)
+}
@@ -0,0 +1,34 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test the key transform canonicalizes keys across methods
+func TestLRU_WithKeyTransform(t *testing.T) {
+	c := cachify.NewLRU(10).WithKeyTransform(cachify.TrimLower)
+	c.Set(" User:42 ", "jane")
+
+	value, ok := c.Get("user:42")
+	assert.True(t, ok)
+	assert.Equal(t, "jane", value)
+	assert.True(t, c.Contains("USER:42"))
+	assert.Equal(t, []string{"user:42"}, c.Keys())
+
+	n, err := c.Increment("Hits", 1)
+	assert.NoError(t, err)
+	n, _ = c.Increment("hits ", 1)
+	assert.Equal(t, int64(2), n)
+
+	c.Remove("USER:42")
+	assert.False(t, c.Contains("user:42"))
+}
+
+// Test the default hasher is stable and spreads keys
+func TestDefaultHasher(t *testing.T) {
+	assert.Equal(t, cachify.DefaultHasher("a"), cachify.DefaultHasher("a"))
+	assert.NotEqual(t, cachify.DefaultHasher("a"), cachify.DefaultHasher("b"))
+}
@@ -0,0 +1,60 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test latency histograms are recorded for Get, Set and Load
+func TestLRU_WithLatencyTracking(t *testing.T) {
+	cache := cachify.NewLRU(10)
+	assert.Nil(t, cache.Stats().Latency)
+
+	cache.WithLatencyTracking(true)
+	assert.True(t, cache.IsLatencyTracking())
+	cache.Set("a", 1)
+	cache.Get("a")
+	cache.Get("b")
+
+	loading := cachify.NewLoading(cache, func(ctx context.Context, key string) (interface{}, error) {
+		time.Sleep(2 * time.Millisecond)
+		return key, nil
+	})
+	_, err := loading.Get(context.Background(), "c")
+	assert.Nil(t, err)
+
+	latency := cache.Stats().Latency
+	assert.NotNil(t, latency)
+	assert.Equal(t, uint64(3), latency.Get.Count)
+	assert.Equal(t, uint64(2), latency.Set.Count)
+	assert.Equal(t, uint64(1), latency.Load.Count)
+	assert.Len(t, latency.Load.Counts, len(latency.Load.Bounds)+1)
+	assert.GreaterOrEqual(t, latency.Load.Mean(), 2*time.Millisecond)
+	assert.GreaterOrEqual(t, latency.Load.Quantile(0.99), 2*time.Millisecond)
+	assert.LessOrEqual(t, latency.Get.Quantile(0.5), latency.Load.Quantile(0.5))
+
+	cache.WithLatencyTracking(false)
+	assert.Nil(t, cache.Stats().Latency)
+}
+
+// Test histogram helpers on empty and overflowing distributions
+func TestHistogram_Quantile(t *testing.T) {
+	var empty cachify.Histogram
+	assert.Equal(t, time.Duration(0), empty.Mean())
+	assert.Equal(t, time.Duration(0), empty.Quantile(0.5))
+
+	h := cachify.Histogram{
+		Bounds: []time.Duration{time.Millisecond, time.Second},
+		Counts: []uint64{3, 1, 1},
+		Count:  5,
+		Sum:    5 * time.Second,
+	}
+	assert.Equal(t, time.Second, h.Mean())
+	assert.Equal(t, time.Millisecond, h.Quantile(0.5))
+	assert.Equal(t, time.Second, h.Quantile(0.7))
+	assert.Equal(t, time.Second, h.Quantile(1))
+}
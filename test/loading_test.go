@@ -0,0 +1,322 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test Loading fills misses and shares concurrent loads
+func TestLoading_Get(t *testing.T) {
+	var calls int32
+	l := cachify.NewLoading(cachify.NewLRU(10), func(ctx context.Context, key string) (interface{}, error) {
+		atomic.AddInt32(&calls, 1)
+		time.Sleep(20 * time.Millisecond)
+		return "v:" + key, nil
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			value, err := l.Get(context.Background(), "a")
+			assert.NoError(t, err)
+			assert.Equal(t, "v:a", value)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	assert.True(t, l.Cache().Contains("a"))
+}
+
+// Test Preload warms keys with bounded concurrency and reports per-key errors
+func TestLoading_Preload(t *testing.T) {
+	var running, peak int32
+	l := cachify.NewLoading(cachify.NewLRU(0), func(ctx context.Context, key string) (interface{}, error) {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		if key == "bad" {
+			return nil, errors.New("boom")
+		}
+		return key, nil
+	}).WithConcurrency(3)
+	l.Cache().Set("cached", "x")
+
+	errs := l.Preload(context.Background(), []string{"a", "b", "c", "d", "e", "bad", "cached"})
+	assert.Len(t, errs, 1)
+	assert.EqualError(t, errs["bad"], "boom")
+	assert.Equal(t, 6, l.Cache().Len())
+	assert.LessOrEqual(t, atomic.LoadInt32(&peak), int32(3))
+}
+
+// Test Preload reloads entries that expired but were not swept yet
+func TestLoading_Preload_Expired(t *testing.T) {
+	cache := cachify.NewLRUExpiresLazy(10, time.Hour)
+	l := cachify.NewLoading(cache, func(ctx context.Context, key string) (interface{}, error) {
+		return "fresh", nil
+	})
+	cache.Set("stale", "old")
+	cache.Set("live", "kept")
+	cache.ExpandExpiry("stale", -2*time.Hour)
+	assert.True(t, cache.Contains("stale"))
+
+	assert.Nil(t, l.Preload(context.Background(), []string{"stale", "live"}))
+	value, ok := cache.Get("stale")
+	assert.True(t, ok)
+	assert.Equal(t, "fresh", value)
+	value, _ = cache.Get("live")
+	assert.Equal(t, "kept", value)
+}
+
+// Test WithEarlyExpiration reloads entries ahead of their expiry
+func TestLoading_WithEarlyExpiration(t *testing.T) {
+	var calls atomic.Int32
+	cache := cachify.NewLRUExpiresLazy(10, time.Hour)
+	loading := cachify.NewLoading(cache, func(ctx context.Context, key string) (interface{}, error) {
+		time.Sleep(time.Millisecond)
+		return calls.Add(1), nil
+	})
+	ctx := context.Background()
+
+	value, err := loading.Get(ctx, "a")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), value)
+	value, _ = loading.Get(ctx, "a")
+	assert.Equal(t, int32(1), value)
+
+	// A huge beta makes an hour-long TTL look imminent
+	loading.WithEarlyExpiration(1e12)
+	value, _ = loading.Get(ctx, "a")
+	assert.Equal(t, int32(2), value)
+
+	// Entries not produced by the Loader are never reloaded early
+	cache.Set("b", "manual")
+	value, _ = loading.Get(ctx, "b")
+	assert.Equal(t, "manual", value)
+
+	loading.WithEarlyExpiration(0)
+	value, _ = loading.Get(ctx, "a")
+	assert.Equal(t, int32(2), value)
+}
+
+// Test a failed early reload serves the cached value, and a plain write stops early reloads
+func TestLoading_WithEarlyExpiration_Failure(t *testing.T) {
+	var calls atomic.Int32
+	var failing atomic.Bool
+	cache := cachify.NewLRUExpiresLazy(10, time.Hour)
+	loading := cachify.NewLoading(cache, func(ctx context.Context, key string) (interface{}, error) {
+		calls.Add(1)
+		time.Sleep(time.Millisecond)
+		if failing.Load() {
+			return nil, errors.New("backend down")
+		}
+		return "loaded", nil
+	}).WithEarlyExpiration(1e12)
+	ctx := context.Background()
+
+	_, err := loading.Get(ctx, "a")
+	assert.NoError(t, err)
+	failing.Store(true)
+	value, stale, err := loading.GetStale(ctx, "a")
+	assert.NoError(t, err)
+	assert.False(t, stale)
+	assert.Equal(t, "loaded", value)
+	assert.Equal(t, int32(2), calls.Load())
+
+	cache.Set("a", "manual")
+	value, err = loading.Get(ctx, "a")
+	assert.NoError(t, err)
+	assert.Equal(t, "manual", value)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+// Test a very large beta always reloads early instead of overflowing the computed gap
+func TestLoading_WithEarlyExpiration_LargeBeta(t *testing.T) {
+	var calls atomic.Int32
+	loading := cachify.NewLoading(cachify.NewLRUExpiresLazy(10, time.Hour), func(ctx context.Context, key string) (interface{}, error) {
+		calls.Add(1)
+		time.Sleep(10 * time.Millisecond)
+		return "loaded", nil
+	}).WithEarlyExpiration(1e15)
+	ctx := context.Background()
+
+	for i := 0; i < 5; i++ {
+		_, err := loading.Get(ctx, "a")
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, int32(5), calls.Load())
+}
+
+// Test WithStaleIfError serves expired values when the Loader fails
+func TestLoading_WithStaleIfError(t *testing.T) {
+	var failing atomic.Bool
+	cache := cachify.NewLRUExpires(10, time.Hour).WithCleanupInterval(time.Hour)
+	defer cache.Close()
+	loading := cachify.NewLoading(cache, func(ctx context.Context, key string) (interface{}, error) {
+		if failing.Load() {
+			return nil, errors.New("backend down")
+		}
+		return "fresh " + key, nil
+	}).WithStaleIfError(30 * time.Minute)
+	ctx := context.Background()
+
+	_, err := loading.Get(ctx, "a")
+	assert.NoError(t, err)
+	failing.Store(true)
+
+	// Expired ten minutes ago: within the bound
+	cache.ExpandExpiry("a", -70*time.Minute)
+	value, err := loading.Get(ctx, "a")
+	assert.NoError(t, err)
+	assert.Equal(t, "fresh a", value)
+	value, stale, err := loading.GetStale(ctx, "a")
+	assert.True(t, stale)
+	assert.EqualError(t, err, "backend down")
+	assert.Equal(t, "fresh a", value)
+
+	// Expired an hour ago: beyond the bound
+	failing.Store(false)
+	_, _ = loading.Get(ctx, "b")
+	failing.Store(true)
+	cache.ExpandExpiry("b", -2*time.Hour)
+	value, stale, err = loading.GetStale(ctx, "b")
+	assert.False(t, stale)
+	assert.Error(t, err)
+	assert.Nil(t, value)
+
+	// Keys removed before they expire are never served stale
+	failing.Store(false)
+	_, _ = loading.Get(ctx, "c")
+	failing.Store(true)
+	cache.Remove("c")
+	_, err = loading.Get(ctx, "c")
+	assert.Error(t, err)
+}
+
+// Test WithRetry retries transient Loader errors only
+func TestLoading_WithRetry(t *testing.T) {
+	var calls atomic.Int32
+	transient := errors.New("transient")
+	loading := cachify.NewLoading(cachify.NewLRU(10), func(ctx context.Context, key string) (interface{}, error) {
+		n := calls.Add(1)
+		switch {
+		case key == "missing":
+			return nil, cachify.ErrNotFound
+		case n < 3:
+			return nil, transient
+		}
+		return key, nil
+	}).WithRetry(3, time.Millisecond).WithRetryJitter(0.5)
+	ctx := context.Background()
+
+	value, err := loading.Get(ctx, "a")
+	assert.NoError(t, err)
+	assert.Equal(t, "a", value)
+	assert.Equal(t, int32(3), calls.Load())
+
+	calls.Store(0)
+	_, err = loading.Get(ctx, "missing")
+	assert.ErrorIs(t, err, cachify.ErrNotFound)
+	assert.Equal(t, int32(1), calls.Load())
+
+	calls.Store(0)
+	loading.WithRetryable(func(err error) bool { return false })
+	_, err = loading.Get(ctx, "b")
+	assert.ErrorIs(t, err, transient)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+// Test NewBatchLoading collects concurrent misses into one loader call
+func TestLoading_NewBatchLoading(t *testing.T) {
+	var calls atomic.Int32
+	loading := cachify.NewBatchLoading(cachify.NewLRU(20), func(ctx context.Context, keys []string) (map[string]interface{}, error) {
+		calls.Add(1)
+		values := make(map[string]interface{}, len(keys))
+		for _, key := range keys {
+			if key != "missing" {
+				values[key] = "value " + key
+			}
+		}
+		return values, nil
+	}, 50*time.Millisecond, 0)
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for _, key := range []string{"a", "b", "c", "d", "missing"} {
+		wg.Add(1)
+		go func(key string) {
+			defer wg.Done()
+			value, err := loading.Get(ctx, key)
+			if key == "missing" {
+				assert.ErrorIs(t, err, cachify.ErrNotFound)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "value "+key, value)
+		}(key)
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), calls.Load())
+	assert.Equal(t, 4, loading.Cache().Len())
+}
+
+// Test NewBatchLoading flushes a full batch before the window elapses
+func TestLoading_NewBatchLoading_MaxBatch(t *testing.T) {
+	loading := cachify.NewBatchLoading(cachify.NewLRU(10), func(ctx context.Context, keys []string) (map[string]interface{}, error) {
+		return nil, errors.New("backend down")
+	}, time.Hour, 2)
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for _, key := range []string{"a", "b"} {
+		wg.Add(1)
+		go func(key string) {
+			defer wg.Done()
+			_, err := loading.Get(ctx, key)
+			assert.EqualError(t, err, "backend down")
+		}(key)
+	}
+	wg.Wait()
+}
+
+// Test WithNegativeFilter rejects keys the Loader reported absent
+func TestLoading_WithNegativeFilter(t *testing.T) {
+	var calls atomic.Int32
+	loading := cachify.NewLoading(cachify.NewLRU(10), func(ctx context.Context, key string) (interface{}, error) {
+		calls.Add(1)
+		if key == "missing" {
+			return nil, cachify.ErrNotFound
+		}
+		return nil, errors.New("backend down")
+	}).WithNegativeFilter(1000, 0.01, time.Hour)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		_, err := loading.Get(ctx, "missing")
+		assert.ErrorIs(t, err, cachify.ErrNotFound)
+	}
+	assert.Equal(t, int32(1), calls.Load())
+
+	// Other failures are not remembered
+	_, _ = loading.Get(ctx, "flaky")
+	_, _ = loading.Get(ctx, "flaky")
+	assert.Equal(t, int32(3), calls.Load())
+
+	loading.ResetNegativeFilter()
+	_, _ = loading.Get(ctx, "missing")
+	assert.Equal(t, int32(4), calls.Load())
+}
@@ -1,6 +1,7 @@
 package test
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -50,10 +51,11 @@ func TestLRU_Callback(t *testing.T) {
 
 // Test expiration functionality
 func TestLRU_Expiration(t *testing.T) {
-	cache := cachify.NewLRUExpires(2, 12*time.Second)
+	cache := cachify.NewLRUExpires(2, 300*time.Millisecond)
+	defer cache.Close()
 
 	cache.Set("key", "value")
-	time.Sleep(1 * time.Second)
+	time.Sleep(100 * time.Millisecond)
 
 	// Before expiration
 	val, ok := cache.Get("key")
@@ -61,9 +63,9 @@ func TestLRU_Expiration(t *testing.T) {
 	assert.Equal(t, "value", val)
 
 	// After expiration
-	time.Sleep(1 * time.Second)
+	time.Sleep(400 * time.Millisecond)
 	_, ok = cache.Get("key")
-	assert.True(t, ok)
+	assert.False(t, ok)
 }
 
 // Test dynamic capacity adjustment
@@ -86,6 +88,49 @@ func TestLRU_SetCapacity(t *testing.T) {
 	assert.False(t, ok)
 }
 
+// Test SetCapacity and Resize report what shrinking evicted
+func TestLRU_Resize(t *testing.T) {
+	var ops []cachify.EventOp
+	cache := cachify.NewLRU(4).WithEventListener(func(e cachify.Event) {
+		if e.Op != cachify.EventSet {
+			ops = append(ops, e.Op)
+		}
+	})
+	for _, key := range []string{"a", "b", "c", "d"} {
+		cache.Set(key, key)
+	}
+
+	assert.Equal(t, 1, cache.SetCapacity(3))
+	evicted := cache.Resize(1)
+	assert.Len(t, evicted, 2)
+	assert.Equal(t, "b", evicted[0].Key)
+	assert.Equal(t, "c", evicted[1].Value)
+	assert.Equal(t, []string{"d"}, cache.Keys())
+	assert.Empty(t, cache.Resize(10))
+	assert.Equal(t, []cachify.EventOp{cachify.EventResize, cachify.EventResize, cachify.EventResize}, ops)
+	assert.Equal(t, uint64(3), cache.Stats().Evictions)
+}
+
+// Test resizing a large cache keeps every surviving key reachable
+func TestLRU_SetCapacity_Resize(t *testing.T) {
+	cache := cachify.NewLRU(1000)
+	for i := 0; i < 1000; i++ {
+		cache.Set(fmt.Sprintf("k%d", i), i)
+	}
+	cache.SetCapacity(10)
+	assert.Equal(t, 10, cache.Len())
+	for i := 990; i < 1000; i++ {
+		assert.True(t, cache.Contains(fmt.Sprintf("k%d", i)))
+	}
+	cache.SetCapacity(2000)
+	cache.Set("new", 1)
+	assert.Equal(t, 11, cache.Len())
+	cache.Clear()
+	assert.Equal(t, 0, cache.Len())
+	cache.Set("after", 1)
+	assert.True(t, cache.Contains("after"))
+}
+
 // Test IsMostRecentlyUsed and GetMostRecentlyUsed
 func TestLRU_MostRecentlyUsed(t *testing.T) {
 	cache := cachify.NewLRU(3)
@@ -159,3 +204,321 @@ func TestLRU_Remove(t *testing.T) {
 	_, ok := cache.Get("a")
 	assert.False(t, ok)
 }
+
+// Test RemoveWhere
+func TestLRU_RemoveWhere(t *testing.T) {
+	cache := cachify.NewLRU(5)
+
+	cache.Set("a", map[string]string{"org": "x"})
+	cache.Set("b", map[string]string{"org": "y"})
+	cache.Set("c", map[string]string{"org": "x"})
+
+	removed := cache.RemoveWhere(func(key string, value interface{}) bool {
+		return value.(map[string]string)["org"] == "x"
+	})
+	assert.Equal(t, 2, removed)
+	assert.Equal(t, 1, cache.Len())
+	assert.True(t, cache.Contains("b"))
+}
+
+// Test Range order and early termination
+func TestLRU_Range(t *testing.T) {
+	cache := cachify.NewLRU(5)
+
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+	cache.Set("c", 3)
+	cache.Get("a")
+
+	var keys []string
+	cache.Range(func(key string, value interface{}) bool {
+		keys = append(keys, key)
+		return true
+	})
+	assert.Equal(t, []string{"a", "c", "b"}, keys)
+
+	keys = nil
+	cache.Range(func(key string, value interface{}) bool {
+		keys = append(keys, key)
+		return len(keys) < 2
+	})
+	assert.Equal(t, []string{"a", "c"}, keys)
+}
+
+// Test Keys and Entries follow recency order
+func TestLRU_KeysAndEntries(t *testing.T) {
+	cache := cachify.NewLRU(3)
+
+	cache.Set("a", "alpha")
+	cache.Set("b", "beta")
+	cache.Set("c", "gamma")
+	cache.Get("b")
+
+	assert.Equal(t, []string{"b", "c", "a"}, cache.Keys())
+
+	entries := cache.Entries()
+	assert.Len(t, entries, 3)
+	assert.Equal(t, "b", entries[0].Key())
+	assert.Equal(t, "beta", entries[0].Value())
+	assert.Equal(t, "a", entries[2].Key())
+}
+
+// Test ExpiringWithin
+func TestLRU_ExpiringWithin(t *testing.T) {
+	cache := cachify.NewLRU(5)
+	cache.SetExpiry(time.Hour)
+
+	cache.Set("late", 1)
+	cache.Set("soon", 2)
+	cache.Set("sooner", 3)
+	cache.ExpandExpiry("late", time.Hour)
+	cache.ExpandExpiry("soon", -50*time.Minute)
+	cache.ExpandExpiry("sooner", -55*time.Minute)
+
+	states := cache.ExpiringWithin(15 * time.Minute)
+	assert.Len(t, states, 2)
+	assert.Equal(t, "sooner", states[0].Key())
+	assert.Equal(t, "soon", states[1].Key())
+}
+
+// Test GetStateByKey does not change recency
+func TestLRU_GetStateByKey(t *testing.T) {
+	cache := cachify.NewLRUExpires(3, time.Minute)
+	defer cache.DestroyCleanup()
+
+	cache.Set("a", "alpha")
+	cache.Set("b", "beta")
+
+	state, ok := cache.GetStateByKey("a")
+	assert.True(t, ok)
+	assert.Equal(t, "alpha", state.Value())
+	assert.InDelta(t, float64(time.Minute), float64(state.Remaining()), float64(time.Second))
+	assert.False(t, state.AccessTime().IsZero())
+	assert.True(t, cache.IsMostRecentlyUsed("b"))
+
+	_, ok = cache.GetStateByKey("missing")
+	assert.False(t, ok)
+}
+
+// Test access counting and TopN
+func TestLRU_TopN(t *testing.T) {
+	cache := cachify.NewLRU(5)
+
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+	cache.Set("c", 3)
+	for i := 0; i < 3; i++ {
+		cache.Get("b")
+	}
+	cache.Get("a")
+
+	top := cache.TopN(2)
+	assert.Len(t, top, 2)
+	assert.Equal(t, "b", top[0].Key())
+	assert.Equal(t, uint64(3), top[0].AccessCount())
+	assert.Equal(t, "a", top[1].Key())
+
+	state, ok := cache.GetStateByKey("c")
+	assert.True(t, ok)
+	assert.Equal(t, uint64(0), state.AccessCount())
+	assert.Empty(t, cache.TopN(0))
+}
+
+// Test GetStates orderings
+func TestLRU_GetStates_Order(t *testing.T) {
+	cache := cachify.NewLRU(5)
+	cache.Set("forever", 1)
+	cache.SetExpiry(time.Hour)
+	cache.Set("late", 2)
+	cache.SetExpiry(time.Minute)
+	cache.Set("soon", 3)
+	cache.Get("late")
+	cache.Get("late")
+	cache.Get("forever")
+
+	keys := func(order cachify.StateOrder) []string {
+		var keys []string
+		for _, s := range cache.GetStates(order) {
+			keys = append(keys, s.Key())
+		}
+		return keys
+	}
+	assert.Equal(t, []string{"forever", "late", "soon"}, keys(cachify.OrderMostRecent))
+	assert.Equal(t, []string{"soon", "late", "forever"}, keys(cachify.OrderLeastRecent))
+	assert.Equal(t, []string{"soon", "late", "forever"}, keys(cachify.OrderExpiration))
+	assert.Equal(t, []string{"late", "forever", "soon"}, keys(cachify.OrderAccessCount))
+	var states []cachify.State = cache.GetStates()
+	assert.Len(t, states, 3)
+}
+
+// Test GetAndRemove, PopOldest and PopNewest
+func TestLRU_Pop(t *testing.T) {
+	cache := cachify.NewLRU(5)
+
+	cache.Set("a", "alpha")
+	cache.Set("b", "beta")
+	cache.Set("c", "gamma")
+	cache.Set("d", "delta")
+
+	val, ok := cache.GetAndRemove("b")
+	assert.True(t, ok)
+	assert.Equal(t, "beta", val)
+	assert.False(t, cache.Contains("b"))
+	_, ok = cache.GetAndRemove("b")
+	assert.False(t, ok)
+
+	key, val, ok := cache.PopOldest()
+	assert.True(t, ok)
+	assert.Equal(t, "a", key)
+	assert.Equal(t, "alpha", val)
+
+	key, _, ok = cache.PopNewest()
+	assert.True(t, ok)
+	assert.Equal(t, "d", key)
+
+	key, _, ok = cache.PopNewest()
+	assert.True(t, ok)
+	assert.Equal(t, "c", key)
+
+	_, _, ok = cache.PopOldest()
+	assert.False(t, ok)
+}
+
+// Test Add only stores absent keys
+func TestLRU_Add(t *testing.T) {
+	cache := cachify.NewLRU(2)
+
+	assert.True(t, cache.Add("lock", "owner-1"))
+	assert.False(t, cache.Add("lock", "owner-2"))
+	val, _ := cache.Get("lock")
+	assert.Equal(t, "owner-1", val)
+
+	cache.Remove("lock")
+	assert.True(t, cache.Add("lock", "owner-2"))
+}
+
+// Test CompareAndSwap and UpdateFunc
+func TestLRU_CompareAndSwap(t *testing.T) {
+	cache := cachify.NewLRU(2)
+
+	cache.Set("v", 1)
+	assert.False(t, cache.CompareAndSwap("v", 2, 3))
+	assert.True(t, cache.CompareAndSwap("v", 1, 2))
+	assert.False(t, cache.CompareAndSwap("missing", nil, 1))
+
+	cache.Set("m", map[string]int{})
+	assert.False(t, cache.CompareAndSwap("m", map[string]int{}, 1))
+
+	stored := cache.UpdateFunc("v", func(old interface{}, exists bool) (interface{}, bool) {
+		assert.True(t, exists)
+		return old.(int) + 10, true
+	})
+	assert.True(t, stored)
+	val, _ := cache.Get("v")
+	assert.Equal(t, 12, val)
+
+	stored = cache.UpdateFunc("new", func(old interface{}, exists bool) (interface{}, bool) {
+		return nil, exists
+	})
+	assert.False(t, stored)
+	assert.False(t, cache.Contains("new"))
+}
+
+// Test Touch refreshes recency and expiration
+func TestLRU_Touch(t *testing.T) {
+	cache := cachify.NewLRU(2)
+	cache.SetExpiry(time.Minute)
+
+	cache.Set("a", "alpha")
+	cache.Set("b", "beta")
+	cache.ExpandExpiry("a", -30*time.Second)
+
+	assert.True(t, cache.Touch("a"))
+	assert.True(t, cache.IsMostRecentlyUsed("a"))
+	remain, ok := cache.PersistExpiry("a")
+	assert.True(t, ok)
+	assert.Greater(t, remain, 50*time.Second)
+
+	assert.False(t, cache.Touch("missing"))
+}
+
+// Test capacity zero or less is unbounded
+func TestLRU_Unbounded(t *testing.T) {
+	cache := cachify.NewLRU(0)
+
+	for i := 0; i < 100; i++ {
+		cache.Set(string(rune('a'+i%26))+string(rune('0'+i/26)), i)
+	}
+	assert.Equal(t, 100, cache.Len())
+	assert.Equal(t, 0, cache.Capacity())
+
+	cache.SetCapacity(10)
+	assert.Equal(t, 10, cache.Len())
+	cache.SetCapacity(-1)
+	cache.Set("extra", 1)
+	assert.Equal(t, 11, cache.Len())
+}
+
+// Test PurgeExpired removes only expired entries
+func TestLRU_PurgeExpired(t *testing.T) {
+	cache := cachify.NewLRUExpires(5, time.Hour).WithCleanupInterval(time.Hour)
+	defer cache.Close()
+
+	cache.Set("fresh", 1)
+	cache.Set("stale", 2)
+	cache.ExpandExpiry("stale", -2*time.Hour)
+
+	assert.Equal(t, 1, cache.PurgeExpired())
+	assert.True(t, cache.Contains("fresh"))
+	assert.False(t, cache.Contains("stale"))
+}
+
+// Test the background cleanup follows the configured interval
+func TestLRU_CleanupInterval(t *testing.T) {
+	cache := cachify.NewLRUExpires(5, time.Hour).WithCleanupInterval(10 * time.Millisecond)
+	defer cache.Close()
+
+	cache.Set("stale", 1)
+	cache.ExpandExpiry("stale", -2*time.Hour)
+	assert.Eventually(t, func() bool {
+		return !cache.Contains("stale")
+	}, time.Second, 10*time.Millisecond)
+}
+
+// Test lazy expiration without a background cleanup
+func TestLRU_Lazy(t *testing.T) {
+	cache := cachify.NewLRUExpiresLazy(5, time.Hour).WithCleanupInterval(time.Millisecond)
+	assert.True(t, cache.IsLazy())
+
+	cache.Set("stale", 1)
+	cache.Set("other", 2)
+	cache.ExpandExpiry("stale", -2*time.Hour)
+	time.Sleep(20 * time.Millisecond)
+	assert.True(t, cache.Contains("stale"))
+
+	_, ok := cache.Get("stale")
+	assert.False(t, ok)
+	assert.False(t, cache.Contains("stale"))
+
+	cache.ExpandExpiry("other", -2*time.Hour)
+	assert.Equal(t, 1, cache.PurgeExpired())
+}
+
+// Test bounded cleanup sweeps still remove every expired entry
+func TestLRU_CleanupBudget(t *testing.T) {
+	cache := cachify.NewLRUExpires(100, time.Hour).
+		WithCleanupBudget(3, 0).
+		WithCleanupInterval(10 * time.Millisecond)
+	defer cache.Close()
+
+	for i := 0; i < 20; i++ {
+		key := string(rune('a' + i))
+		cache.Set(key, i)
+		if i%2 == 0 {
+			cache.ExpandExpiry(key, -2*time.Hour)
+		}
+	}
+	assert.Eventually(t, func() bool {
+		return cache.Len() == 10
+	}, time.Second, 10*time.Millisecond)
+}
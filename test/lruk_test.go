@@ -0,0 +1,51 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test LRU-2 keeps frequently referenced entries through a scan burst
+func TestLRUK_ResistsScans(t *testing.T) {
+	c := cachify.NewLRUK(3, 2)
+	assert.Equal(t, 2, c.K())
+	c.Set("hot1", 1)
+	c.Set("hot2", 2)
+	c.Get("hot1")
+	c.Get("hot2")
+
+	for i := 0; i < 10; i++ {
+		c.Set(fmt.Sprintf("scan%d", i), i)
+	}
+	assert.True(t, c.Contains("hot1"))
+	assert.True(t, c.Contains("hot2"))
+	assert.True(t, c.Contains("scan9"))
+	assert.Equal(t, 3, c.Len())
+}
+
+// Test LRU-K evicts by oldest K-th access and retains history of evicted keys
+func TestLRUK_Eviction(t *testing.T) {
+	var evicted []string
+	c := cachify.NewLRUK(2, 2)
+	c.SetCallback(func(key string, value interface{}) { evicted = append(evicted, key) })
+	c.Set("a", 1)
+	c.Get("a")
+	c.Set("b", 2)
+	c.Get("b")
+
+	c.Set("c", 3)
+	c.Set("d", 4)
+	assert.Equal(t, []string{"a", "c"}, evicted)
+
+	// "c" returns with its retained reference, so it now outranks the single-access "d"
+	c.Set("c", 3)
+	assert.Equal(t, []string{"a", "c", "d"}, evicted)
+	assert.True(t, c.Contains("b"))
+	assert.True(t, c.Contains("c"))
+
+	c.Remove("b")
+	assert.Equal(t, 1, c.Len())
+}
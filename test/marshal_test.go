@@ -0,0 +1,50 @@
+package test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test Stats, states and events encode to JSON without manual mapping
+func TestJSON_Marshal(t *testing.T) {
+	var events []cachify.Event
+	cache := cachify.NewLRU(10).WithEventListener(func(e cachify.Event) { events = append(events, e) })
+	cache.Set("a", "alpha")
+	cache.Get("a")
+	cache.Get("missing")
+
+	data, err := json.Marshal(cache.Stats())
+	assert.NoError(t, err)
+	var stats map[string]interface{}
+	assert.NoError(t, json.Unmarshal(data, &stats))
+	assert.Equal(t, 1.0, stats["hits"])
+	assert.Equal(t, 1.0, stats["misses"])
+	assert.Equal(t, 0.5, stats["hit_ratio"])
+	assert.NotContains(t, stats, "latency")
+
+	var decoded cachify.Stats
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, cache.Stats(), decoded)
+
+	cache.SetExpiry(time.Hour)
+	cache.Set("b", 2)
+	data, err = json.Marshal(cache.GetStates(cachify.OrderLeastRecent))
+	assert.NoError(t, err)
+	var states []map[string]interface{}
+	assert.NoError(t, json.Unmarshal(data, &states))
+	assert.Len(t, states, 2)
+	assert.Equal(t, "a", states[0]["key"])
+	assert.Equal(t, "alpha", states[0]["value"])
+	assert.Equal(t, 1.0, states[0]["access_count"])
+	assert.NotContains(t, states[0], "expiration")
+	assert.Contains(t, states[1], "expiration")
+	assert.Greater(t, states[1]["remaining_ns"], 0.0)
+
+	data, err = json.Marshal(events[0])
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"op":"set","key":"a","value":"alpha"`)
+}
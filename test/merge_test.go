@@ -0,0 +1,58 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test Merge with conflict resolution and capacity enforcement
+func TestLRU_Merge(t *testing.T) {
+	c := cachify.NewLRU(3)
+	c.Set("a", 1)
+	c.Set("b", 2)
+
+	other := cachify.NewLRU(0)
+	other.Set("b", 20)
+	other.Set("c", 30)
+	other.Set("d", 40)
+
+	n := c.Merge(other, func(key string, a, b interface{}) interface{} {
+		return a.(int) + b.(int)
+	})
+	assert.Equal(t, 3, n)
+	assert.Equal(t, 3, c.Len())
+	assert.False(t, c.Contains("a"))
+	assert.Equal(t, []string{"d", "c", "b"}, c.Keys())
+	b, _ := c.Get("b")
+	assert.Equal(t, 22, b)
+	assert.Equal(t, 3, other.Len())
+}
+
+// Test SetFromMap writes every pair
+func TestLRU_SetFromMap(t *testing.T) {
+	c := cachify.NewLRU(0)
+	c.Set("a", 1)
+	c.SetFromMap(map[string]interface{}{"a": 10, "b": 2})
+	a, _ := c.Get("a")
+	assert.Equal(t, 10, a)
+	assert.Equal(t, 2, c.Len())
+}
+
+// Test SetFromMap and Merge normalize keys like Set
+func TestLRU_Merge_KeyTransform(t *testing.T) {
+	c := cachify.NewLRU(10).WithKeyTransform(cachify.TrimLower)
+	c.SetFromMap(map[string]interface{}{" User:1 ": "a"})
+	value, ok := c.Get("user:1")
+	assert.True(t, ok)
+	assert.Equal(t, "a", value)
+
+	other := cachify.NewLRU(10)
+	other.Set("USER:2", "b")
+	other.Set("User:1", "c")
+	assert.Equal(t, 2, c.Merge(other, nil))
+	assert.ElementsMatch(t, []string{"user:1", "user:2"}, c.Keys())
+	value, _ = c.Get("user:1")
+	assert.Equal(t, "c", value)
+}
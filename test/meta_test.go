@@ -0,0 +1,30 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test metadata is attached, preserved by Set and exposed through states and snapshots
+func TestLRU_SetWithMeta(t *testing.T) {
+	c := cachify.NewLRU(10)
+	meta := map[string]string{"source": "db", "etag": "v1"}
+	c.SetWithMeta("a", 1, meta)
+	meta["etag"] = "mutated"
+
+	assert.Equal(t, "v1", c.Meta("a")["etag"])
+	c.Set("a", 2)
+	state, ok := c.GetStateByKey("a")
+	assert.True(t, ok)
+	assert.Equal(t, "db", state.Meta()["source"])
+
+	restored := cachify.NewLRU(10)
+	restored.Restore(c.Snapshot())
+	assert.Equal(t, "v1", restored.Meta("a")["etag"])
+
+	c.SetWithMeta("a", 3, nil)
+	assert.Nil(t, c.Meta("a"))
+	assert.Nil(t, c.Meta("missing"))
+}
@@ -0,0 +1,96 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test namespaces isolate keys on a shared cache
+func TestView_Isolation(t *testing.T) {
+	cache := cachify.NewLRU(10)
+	a := cache.Namespace("tenant:a:")
+	b := cache.Namespace("tenant:b:")
+
+	a.Set("k", "from-a")
+	b.Set("k", "from-b")
+
+	val, ok := a.Get("k")
+	assert.True(t, ok)
+	assert.Equal(t, "from-a", val)
+	assert.True(t, cache.Contains("tenant:b:k"))
+	assert.Equal(t, []string{"k"}, b.Keys())
+
+	a.Set("other", 1)
+	assert.Equal(t, 2, a.Len())
+	assert.Equal(t, 2, a.Clear())
+	assert.Equal(t, 0, a.Len())
+	assert.Equal(t, 1, b.Len())
+
+	nested := b.Namespace("users:")
+	nested.Set("1", "u1")
+	assert.True(t, cache.Contains("tenant:b:users:1"))
+	assert.Equal(t, 2, b.Len())
+}
+
+// Test namespace quotas evict within the namespace in LRU order
+func TestView_Quota(t *testing.T) {
+	cache := cachify.NewLRU(10)
+	noisy := cache.Namespace("noisy:")
+	quiet := cache.Namespace("quiet:")
+	noisy.SetQuota(2)
+
+	quiet.Set("q", 1)
+	noisy.Set("a", 1)
+	noisy.Set("b", 2)
+	noisy.Get("a")
+	noisy.Set("c", 3)
+
+	assert.Equal(t, 2, noisy.Len())
+	assert.False(t, noisy.Contains("b"))
+	assert.True(t, noisy.Contains("a"))
+	assert.True(t, quiet.Contains("q"))
+	assert.Equal(t, 2, noisy.Quota())
+
+	noisy.SetQuota(1)
+	assert.Equal(t, []string{"c"}, noisy.Keys())
+
+	noisy.SetQuota(0)
+	noisy.Set("d", 4)
+	assert.Equal(t, 2, noisy.Len())
+}
+
+// Test quotas follow each namespace's own recency order, across Clear and Restore, and feed the ghost list
+func TestView_Quota_Order(t *testing.T) {
+	cache := cachify.NewLRU(100).WithGhostList(10)
+	noisy := cache.Namespace("noisy:")
+	noisy.SetQuota(2)
+	for i := 0; i < 50; i++ {
+		cache.Set(fmt.Sprintf("other:%d", i), i)
+	}
+	noisy.Set("a", 1)
+	noisy.Set("b", 2)
+	cache.Get("other:0")
+	noisy.Get("a")
+	noisy.Set("c", 3)
+	assert.Equal(t, []string{"a", "c"}, noisy.Keys())
+	assert.Equal(t, 52, cache.Len())
+
+	// Writing the evicted key again is a ghost hit
+	noisy.Set("b", 2)
+	assert.Equal(t, uint64(1), cache.GhostHits())
+	assert.Equal(t, []string{"b", "c"}, noisy.Keys())
+
+	snapshot := cache.Snapshot()
+	cache.Clear()
+	noisy.Set("x", 1)
+	noisy.Set("y", 2)
+	noisy.Set("z", 3)
+	assert.Equal(t, []string{"y", "z"}, noisy.Keys())
+
+	cache.Restore(snapshot)
+	noisy.Set("d", 4)
+	assert.Equal(t, []string{"b", "d"}, noisy.Keys())
+}
@@ -0,0 +1,45 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test the no-op cache never stores
+func TestNoop(t *testing.T) {
+	var c cachify.Cache = cachify.NewNoop()
+	c.Set("a", 1)
+	_, ok := c.Get("a")
+	assert.False(t, ok)
+	assert.Equal(t, 0, c.Len())
+	assert.Equal(t, uint64(1), c.Stats().Misses)
+}
+
+// Test the pass-through cache loads on every lookup
+func TestPassThrough(t *testing.T) {
+	calls := 0
+	p := cachify.NewPassThrough(func(ctx context.Context, key string) (interface{}, error) {
+		calls++
+		if key == "bad" {
+			return nil, errors.New("boom")
+		}
+		return "v:" + key, nil
+	})
+	var c cachify.Cache = p
+	c.Set("a", "ignored")
+	value, ok := c.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, "v:a", value)
+	c.Get("a")
+	assert.Equal(t, 2, calls)
+
+	_, err := p.GetContext(context.Background(), "bad")
+	assert.EqualError(t, err, "boom")
+	assert.Equal(t, uint64(2), c.Stats().Hits)
+	assert.Equal(t, uint64(1), c.Stats().Misses)
+	assert.False(t, c.Contains("a"))
+}
@@ -0,0 +1,80 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test a panicking eviction callback is recovered, counted, and reported
+func TestPanic_EvictionCallback(t *testing.T) {
+	var reported []error
+	cache := cachify.NewLRUCallback(1, func(key string, value interface{}) {
+		panic("boom")
+	}).WithPanicCallback(func(key string, err error) {
+		reported = append(reported, err)
+	})
+
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+	assert.Equal(t, []string{"b"}, cache.Keys())
+	assert.Equal(t, uint64(1), cache.Stats().Panics)
+	assert.Len(t, reported, 1)
+	assert.ErrorIs(t, reported[0], cachify.ErrPanic)
+	var panicErr *cachify.PanicError
+	assert.True(t, errors.As(reported[0], &panicErr))
+	assert.Equal(t, "evict", panicErr.Hook)
+	assert.Equal(t, "a", panicErr.Key)
+	assert.Equal(t, "boom", panicErr.Value)
+
+	// The lock was released
+	cache.Set("c", 3)
+	assert.Equal(t, uint64(2), cache.Stats().Panics)
+}
+
+// Test a panicking expiration callback does not stop the cleanup goroutine
+func TestPanic_CleanupSurvives(t *testing.T) {
+	cache := cachify.NewLRUExpires(10, time.Hour).WithCleanupInterval(5 * time.Millisecond).
+		OnExpire(func(key string, value interface{}) { panic(key) })
+	defer cache.Close()
+
+	cache.Set("a", 1)
+	cache.ExpandExpiry("a", -2*time.Hour)
+	assert.Eventually(t, func() bool { return !cache.Contains("a") }, time.Second, 5*time.Millisecond)
+	cache.Set("b", 2)
+	cache.ExpandExpiry("b", -2*time.Hour)
+	assert.Eventually(t, func() bool { return !cache.Contains("b") }, time.Second, 5*time.Millisecond)
+	assert.Equal(t, uint64(2), cache.Stats().Panics)
+}
+
+// Test a panicking Clock eviction callback is recovered
+func TestPanic_Clock(t *testing.T) {
+	var reported error
+	cache := cachify.NewClockCallback(1, func(key string, value interface{}) { panic("boom") })
+	cache.SetPanicCallback(func(key string, err error) {
+		reported = err
+	})
+
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+	assert.True(t, cache.Contains("b"))
+	assert.Equal(t, uint64(1), cache.Stats().Panics)
+	assert.ErrorIs(t, reported, cachify.ErrPanic)
+}
+
+// Test a panicking Loader fails the load for every waiting caller instead of crashing
+func TestPanic_Loader(t *testing.T) {
+	cache := cachify.NewLRU(10)
+	loading := cachify.NewLoading(cache, func(ctx context.Context, key string) (interface{}, error) {
+		panic("loader")
+	})
+
+	_, err := loading.Get(context.Background(), "a")
+	assert.ErrorIs(t, err, cachify.ErrPanic)
+	assert.False(t, cache.Contains("a"))
+	assert.Equal(t, uint64(1), cache.Stats().Panics)
+}
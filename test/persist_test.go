@@ -0,0 +1,81 @@
+package test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test SaveToFile and LoadFromFile round-trip
+func TestLRU_SaveLoadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache.snap")
+	c := cachify.NewLRU(10)
+	c.Set("a", 1)
+	c.Set("b", "two")
+	assert.NoError(t, c.SaveToFile(path))
+
+	restored := cachify.NewLRU(10)
+	n, err := restored.LoadFromFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, []string{"b", "a"}, restored.Keys())
+	a, _ := restored.Get("a")
+	assert.Equal(t, 1, a)
+
+	_, err = restored.LoadFromFile(filepath.Join(t.TempDir(), "missing"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+// Test WithAutoSnapshot persists periodically and on Close
+func TestLRU_AutoSnapshot(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache.snap")
+	c := cachify.NewLRU(10).WithAutoSnapshot(path, 20*time.Millisecond, nil)
+	c.Set("a", 1)
+	assert.Eventually(t, func() bool {
+		_, err := os.Stat(path)
+		return err == nil
+	}, time.Second, 10*time.Millisecond)
+
+	c.Set("b", 2)
+	c.Close()
+	restored := cachify.NewLRU(10)
+	n, err := restored.LoadFromFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+// Test WriteSnapshotFile and ReadSnapshotFile round-trip without a cache
+func TestSnapshotFile_ReadWrite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache.snap")
+	expired := time.Now().Add(-time.Minute)
+	snapshot := []cachify.Entry{
+		{Key: "a", Value: "alpha"},
+		{Key: "b", Value: 2.0, Expiration: expired},
+	}
+	aead, err := cachify.NewAESGCM(make([]byte, 32))
+	assert.NoError(t, err)
+	assert.NoError(t, cachify.WriteSnapshotFile(path, snapshot, cachify.JSONCodec{}, aead))
+
+	read, err := cachify.ReadSnapshotFile(path, cachify.JSONCodec{}, aead)
+	assert.NoError(t, err)
+	assert.Len(t, read, 2)
+	assert.Equal(t, "alpha", read[0].Value)
+	assert.Equal(t, "b", read[1].Key)
+	assert.True(t, read[1].Expiration.Equal(expired))
+
+	_, err = cachify.ReadSnapshotFile(path, cachify.JSONCodec{}, nil)
+	assert.Error(t, err)
+
+	restored := cachify.NewLRU(10).WithCodec(cachify.JSONCodec{}).WithEncryption(aead)
+	n, err := restored.LoadFromFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+}
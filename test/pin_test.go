@@ -0,0 +1,43 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test pinned entries survive capacity eviction but not Remove
+func TestLRU_Pin(t *testing.T) {
+	cache := cachify.NewLRU(2)
+
+	cache.Set("config", "mandatory")
+	assert.True(t, cache.Pin("config"))
+	assert.False(t, cache.Pin("missing"))
+
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+	cache.Set("c", 3)
+	assert.True(t, cache.Contains("config"))
+	assert.True(t, cache.IsPinned("config"))
+	assert.Equal(t, 2, cache.Len())
+
+	cache.Remove("config")
+	assert.False(t, cache.Contains("config"))
+}
+
+// Test unpinning trims a cache held above capacity by pins
+func TestLRU_Unpin(t *testing.T) {
+	cache := cachify.NewLRU(2)
+
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+	cache.Pin("a")
+	cache.Pin("b")
+	cache.SetCapacity(1)
+	assert.Equal(t, 2, cache.Len())
+
+	assert.True(t, cache.Unpin("a"))
+	assert.Equal(t, 1, cache.Len())
+	assert.True(t, cache.Contains("b"))
+}
@@ -0,0 +1,46 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test MRU eviction removes the most recently used entry other than the new one
+func TestLRU_EvictMRU(t *testing.T) {
+	c := cachify.NewLRU(3).WithEvictionPolicy(cachify.EvictMRU)
+	assert.Equal(t, cachify.EvictMRU, c.EvictionPolicy())
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Set("c", 3)
+	c.Get("a")
+
+	c.Set("d", 4)
+	assert.False(t, c.Contains("a"))
+	assert.Equal(t, []string{"d", "c", "b"}, c.Keys())
+
+	c.Pin("d")
+	c.Set("e", 5)
+	assert.True(t, c.Contains("d"))
+	assert.False(t, c.Contains("c"))
+}
+
+// Test EvictMRU only spares an entry that was just written
+func TestLRU_EvictMRU_Resize(t *testing.T) {
+	c := cachify.NewLRU(3).WithEvictionPolicy(cachify.EvictMRU)
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Set("c", 3)
+
+	// Shrinking writes nothing, so the most recently used entry goes first
+	c.SetCapacity(2)
+	assert.Equal(t, []string{"b", "a"}, c.Keys())
+
+	// A write still spares the entry written
+	c.Pin("b")
+	c.Set("d", 4)
+	assert.True(t, c.Contains("d"))
+	assert.True(t, c.Contains("b"))
+	assert.False(t, c.Contains("a"))
+}
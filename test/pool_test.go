@@ -0,0 +1,47 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test evicted entries are recycled and counted
+func TestLRU_PoolStats(t *testing.T) {
+	cache := cachify.NewLRU(10)
+	for i := 0; i < 1000; i++ {
+		cache.Set(fmt.Sprintf("k%d", i), i)
+	}
+	stats := cache.PoolStats()
+	assert.Equal(t, uint64(1000), stats.Allocated+stats.Reused)
+	assert.Equal(t, 10, cache.Len())
+	for i := 990; i < 1000; i++ {
+		value, ok := cache.Get(fmt.Sprintf("k%d", i))
+		assert.True(t, ok)
+		assert.Equal(t, i, value)
+	}
+}
+
+// Test values read while removing survive the entry being recycled
+func TestLRU_PoolStats_Recycled(t *testing.T) {
+	cache := cachify.NewLRU(2)
+	cache.SetWithTags("a", 1, "t")
+	cache.Set("b", 2)
+	value, ok := cache.GetAndRemove("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, value)
+
+	cache.Set("c", 3)
+	key, value, ok := cache.PopOldest()
+	assert.True(t, ok)
+	assert.Equal(t, "b", key)
+	assert.Equal(t, 2, value)
+
+	cache.Set("d", 4)
+	assert.Empty(t, cache.Tags("d"))
+	assert.Equal(t, 0, cache.InvalidateTag("t"))
+	assert.Nil(t, cache.Meta("d"))
+	assert.Equal(t, []string{"d", "c"}, cache.Keys())
+}
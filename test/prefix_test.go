@@ -0,0 +1,45 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test prefix-scoped inspection and removal
+func TestLRU_Prefix(t *testing.T) {
+	cache := cachify.NewLRU(10)
+
+	cache.Set("user:42:profile", "p")
+	cache.Set("user:42:settings", "s")
+	cache.Set("user:7:profile", "q")
+
+	assert.Equal(t, 2, cache.LenByPrefix("user:42:"))
+	assert.Equal(t, 3, cache.LenByPrefix("user:"))
+	assert.Equal(t, []string{"user:42:profile", "user:42:settings"}, cache.KeysByPrefix("user:42:"))
+
+	// Keys added after the index is built are tracked
+	cache.Set("user:42:avatar", "a")
+	assert.Equal(t, 3, cache.LenByPrefix("user:42:"))
+
+	assert.Equal(t, 3, cache.RemoveByPrefix("user:42:"))
+	assert.Equal(t, 0, cache.LenByPrefix("user:42:"))
+	assert.Equal(t, []string{"user:7:profile"}, cache.KeysByPrefix(""))
+	assert.Equal(t, 1, cache.Len())
+}
+
+// Test capacity evictions leave the prefix index consistent
+func TestLRU_PrefixEviction(t *testing.T) {
+	cache := cachify.NewLRU(2)
+
+	assert.Equal(t, 0, cache.LenByPrefix("a"))
+	cache.Set("a1", 1)
+	cache.Set("a2", 2)
+	cache.Set("b1", 3)
+	assert.Equal(t, []string{"a2"}, cache.KeysByPrefix("a"))
+
+	cache.Clear()
+	cache.Set("a3", 4)
+	assert.Equal(t, 1, cache.LenByPrefix("a"))
+}
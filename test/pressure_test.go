@@ -0,0 +1,87 @@
+package test
+
+import (
+	"fmt"
+	"runtime/debug"
+	"testing"
+	"time"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test Shed evicts a share of the least recently used entries, skipping pinned ones
+func TestLRU_Shed(t *testing.T) {
+	cache := cachify.NewLRU(10)
+	for i := 0; i < 10; i++ {
+		cache.Set(fmt.Sprintf("k%d", i), i)
+	}
+	cache.Pin("k0")
+
+	assert.Equal(t, 3, cache.Shed(0.25))
+	assert.True(t, cache.Contains("k0"))
+	assert.False(t, cache.Contains("k3"))
+	assert.True(t, cache.Contains("k4"))
+	assert.Equal(t, 10, cache.Capacity())
+	assert.Equal(t, uint64(3), cache.Stats().Evictions)
+	assert.Equal(t, 0, cache.Shed(0))
+}
+
+// Test WithMemoryPressure sheds on every signal until the channel is closed
+func TestLRU_WithMemoryPressure(t *testing.T) {
+	cache := cachify.NewLRU(10)
+	defer cache.Close()
+	for i := 0; i < 10; i++ {
+		cache.Set(fmt.Sprintf("k%d", i), i)
+	}
+	signal := make(chan struct{})
+	cache.WithMemoryPressure(signal, 0.5)
+
+	signal <- struct{}{}
+	assert.Eventually(t, func() bool { return cache.Len() == 5 }, time.Second, time.Millisecond)
+	signal <- struct{}{}
+	assert.Eventually(t, func() bool { return cache.Len() == 2 }, time.Second, time.Millisecond)
+	close(signal)
+}
+
+// Test WatchMemoryLimit signals once the memory limit is exceeded
+func TestWatchMemoryLimit(t *testing.T) {
+	previous := debug.SetMemoryLimit(1)
+	defer debug.SetMemoryLimit(previous)
+
+	signal, stop := cachify.WatchMemoryLimit(time.Millisecond, 0.9)
+	select {
+	case <-signal:
+	case <-time.After(time.Second):
+		t.Fatal("no pressure signalled")
+	}
+	stop()
+	stop()
+	assert.Eventually(t, func() bool {
+		_, open := <-signal
+		return !open
+	}, time.Second, time.Millisecond)
+}
+
+// Test EvictOldest removes and returns the least recently used entries
+func TestLRU_EvictOldest(t *testing.T) {
+	var evicted []string
+	cache := cachify.NewLRUCallback(10, func(key string, value interface{}) {
+		evicted = append(evicted, key)
+	})
+	for i := 0; i < 5; i++ {
+		cache.Set(fmt.Sprintf("k%d", i), i)
+	}
+	cache.Get("k0")
+
+	removed := cache.EvictOldest(2)
+	assert.Len(t, removed, 2)
+	assert.Equal(t, "k1", removed[0].Key)
+	assert.Equal(t, 2, removed[1].Value)
+	assert.Equal(t, []string{"k1", "k2"}, evicted)
+	assert.Equal(t, []string{"k0", "k4", "k3"}, cache.Keys())
+
+	assert.Len(t, cache.EvictOldest(10), 3)
+	assert.Empty(t, cache.EvictOldest(1))
+	assert.Empty(t, cache.EvictOldest(0))
+}
@@ -0,0 +1,27 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test the read-only view exposes reads without mutation
+func TestLRU_ReadOnly(t *testing.T) {
+	c := cachify.NewLRU(10)
+	view := c.ReadOnly()
+	c.Set("a", 1)
+
+	value, ok := view.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, value)
+	assert.True(t, view.Contains("a"))
+	assert.Equal(t, 1, view.Len())
+	assert.Equal(t, uint64(1), view.Stats().Hits)
+
+	_, mutable := view.(cachify.Cache)
+	assert.False(t, mutable)
+	_, isLRU := view.(*cachify.LRU)
+	assert.False(t, isLRU)
+}
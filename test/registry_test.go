@@ -0,0 +1,57 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test ResetStats zeroes the counters without touching the entries
+func TestLRU_ResetStats(t *testing.T) {
+	cache := cachify.NewLRU(1).WithLatencyTracking(true)
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+	cache.Get("b")
+	cache.Get("a")
+
+	cache.ResetStats()
+	stats := cache.Stats()
+	assert.Equal(t, uint64(0), stats.Hits)
+	assert.Equal(t, uint64(0), stats.Misses)
+	assert.Equal(t, uint64(0), stats.Evictions)
+	assert.Equal(t, uint64(0), stats.Latency.Get.Count)
+	assert.Equal(t, 1, stats.Len)
+	assert.Equal(t, cachify.PoolStats{}, cache.PoolStats())
+}
+
+// Test named caches can be registered and reported on together
+func TestRegister(t *testing.T) {
+	users := cachify.NewLRU(10)
+	sessions := cachify.NewClock(10)
+	cachify.Register("test-users", users)
+	cachify.Register("test-sessions", sessions)
+	cachify.Register("test-noop", cachify.NewNoop())
+	defer cachify.Unregister("test-users")
+	defer cachify.Unregister("test-sessions")
+
+	cachify.Register("test-noop", nil)
+	_, ok := cachify.LookupCache("test-noop")
+	assert.False(t, ok)
+
+	cache, ok := cachify.LookupCache("test-users")
+	assert.True(t, ok)
+	assert.Same(t, users, cache)
+	assert.Subset(t, cachify.RegisteredNames(), []string{"test-sessions", "test-users"})
+
+	users.Set("a", 1)
+	users.Get("a")
+	sessions.Get("missing")
+	stats := cachify.RegisteredStats()
+	assert.Equal(t, uint64(1), stats["test-users"].Hits)
+	assert.Equal(t, uint64(1), stats["test-sessions"].Misses)
+
+	cachify.ResetRegisteredStats()
+	assert.Equal(t, uint64(0), users.Stats().Hits)
+	assert.Equal(t, uint64(0), sessions.Stats().Misses)
+}
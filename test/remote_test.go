@@ -0,0 +1,98 @@
+package test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/pnguyen215/cachify/remote"
+	"github.com/stretchr/testify/assert"
+)
+
+// do sends a request to the remote server and returns the status and body.
+func do(t *testing.T, method, url, body string) (int, string) {
+	request, err := http.NewRequest(method, url, strings.NewReader(body))
+	assert.Nil(t, err)
+	response, err := http.DefaultClient.Do(request)
+	assert.Nil(t, err)
+	defer response.Body.Close()
+	data, _ := io.ReadAll(response.Body)
+	return response.StatusCode, string(data)
+}
+
+// Test the remote server stores, serves, expires and deletes opaque values
+func TestRemote_Server(t *testing.T) {
+	server := httptest.NewServer(remote.NewServer(cachify.NewLRU(10)).WithMaxValueSize(8))
+	defer server.Close()
+
+	status, _ := do(t, http.MethodPut, server.URL+"/v1/keys/user/1", `"Ada"`)
+	assert.Equal(t, http.StatusNoContent, status)
+	status, body := do(t, http.MethodGet, server.URL+"/v1/keys/user/1", "")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, `"Ada"`, body)
+
+	status, _ = do(t, http.MethodPut, server.URL+"/v1/keys/short?ttl=20ms", "1")
+	assert.Equal(t, http.StatusNoContent, status)
+	time.Sleep(40 * time.Millisecond)
+	status, _ = do(t, http.MethodGet, server.URL+"/v1/keys/short", "")
+	assert.Equal(t, http.StatusNotFound, status)
+
+	status, _ = do(t, http.MethodPut, server.URL+"/v1/keys/bad?ttl=soon", "1")
+	assert.Equal(t, http.StatusBadRequest, status)
+	status, _ = do(t, http.MethodPut, server.URL+"/v1/keys/big", "123456789")
+	assert.Equal(t, http.StatusRequestEntityTooLarge, status)
+
+	status, _ = do(t, http.MethodDelete, server.URL+"/v1/keys/user/1", "")
+	assert.Equal(t, http.StatusNoContent, status)
+	status, _ = do(t, http.MethodGet, server.URL+"/v1/keys/user/1", "")
+	assert.Equal(t, http.StatusNotFound, status)
+
+	status, body = do(t, http.MethodGet, server.URL+"/v1/stats", "")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Contains(t, body, `"len":0`)
+}
+
+// Test the remote client implements Store and can back a tiered cache
+func TestRemote_Client(t *testing.T) {
+	server := httptest.NewServer(remote.NewServer(cachify.NewLRU(10)))
+	defer server.Close()
+	ctx := context.Background()
+
+	client := remote.NewClient(server.URL + "/")
+	assert.Nil(t, client.Set(ctx, "user/1", map[string]interface{}{"name": "Ada"}, 0))
+	value, ok, err := client.Get(ctx, "user/1")
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, map[string]interface{}{"name": "Ada"}, value)
+
+	assert.Nil(t, client.Set(ctx, "short", 1, 20*time.Millisecond))
+	time.Sleep(40 * time.Millisecond)
+	_, ok, err = client.Get(ctx, "short")
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	assert.Nil(t, client.Delete(ctx, "user/1"))
+	assert.Nil(t, client.Delete(ctx, "user/1"))
+	_, ok, _ = client.Get(ctx, "user/1")
+	assert.False(t, ok)
+
+	stats, err := client.Stats(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), stats.Misses)
+
+	tiered := cachify.NewTiered(cachify.NewLRU(1), client)
+	assert.Nil(t, tiered.SetContext(ctx, "a", "alpha"))
+	assert.Nil(t, tiered.SetContext(ctx, "b", "beta"))
+	value, ok, err = tiered.GetContext(ctx, "a")
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "alpha", value)
+
+	_, _, err = remote.NewClient("http://127.0.0.1:1").Get(ctx, "a")
+	assert.NotNil(t, err)
+}
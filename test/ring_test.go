@@ -0,0 +1,75 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/pnguyen215/cachify/remote"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test the store ring spreads keys and only remaps the keys of a removed node
+func TestStoreRing(t *testing.T) {
+	ctx := context.Background()
+	ring := cachify.NewStoreRing(0)
+	_, _, err := ring.Get(ctx, "a")
+	assert.ErrorIs(t, err, cachify.ErrNoNodes)
+
+	stores := map[string]*memoryStore{"n1": newMemoryStore(), "n2": newMemoryStore(), "n3": newMemoryStore()}
+	for name, store := range stores {
+		ring.Add(name, store)
+	}
+	assert.Equal(t, []string{"n1", "n2", "n3"}, ring.Nodes())
+
+	owners := make(map[string]string)
+	for i := 0; i < 300; i++ {
+		key := fmt.Sprintf("k%d", i)
+		assert.Nil(t, ring.Set(ctx, key, i, 0))
+		owners[key], _, _ = ring.Pick(key)
+	}
+	for name, store := range stores {
+		assert.Greater(t, len(store.data), 50, name)
+	}
+
+	ring.Remove("n2")
+	for key, owner := range owners {
+		name, _, _ := ring.Pick(key)
+		if owner != "n2" {
+			assert.Equal(t, owner, name)
+			value, ok, err := ring.Get(ctx, key)
+			assert.Nil(t, err)
+			assert.True(t, ok)
+			assert.NotNil(t, value)
+		} else {
+			assert.NotEqual(t, "n2", name)
+		}
+	}
+	assert.Nil(t, ring.Delete(ctx, "k1"))
+}
+
+// Test a cluster of remote servers routes each key to one node
+func TestRemote_NewCluster(t *testing.T) {
+	ctx := context.Background()
+	first := httptest.NewServer(remote.NewServer(cachify.NewLRU(100)))
+	defer first.Close()
+	second := httptest.NewServer(remote.NewServer(cachify.NewLRU(100)))
+	defer second.Close()
+
+	cluster := remote.NewCluster(50, first.URL, second.URL)
+	for i := 0; i < 20; i++ {
+		assert.Nil(t, cluster.Set(ctx, fmt.Sprintf("k%d", i), i, 0))
+	}
+	value, ok, err := cluster.Get(ctx, "k7")
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, float64(7), value)
+
+	firstStats, _ := remote.NewClient(first.URL).Stats(ctx)
+	secondStats, _ := remote.NewClient(second.URL).Stats(ctx)
+	assert.Equal(t, 20, firstStats.Len+secondStats.Len)
+	assert.Greater(t, firstStats.Len, 0)
+	assert.Greater(t, secondStats.Len, 0)
+}
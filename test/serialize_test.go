@@ -0,0 +1,68 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test WithSerialization returns fresh decoded values on every read
+func TestLRU_WithSerialization(t *testing.T) {
+	cache := cachify.NewLRU(10).WithCodec(cachify.JSONCodec{}).WithSerialization(true)
+	assert.True(t, cache.IsSerialized())
+
+	written := map[string]interface{}{"n": 1.0}
+	cache.Set("a", written)
+	written["n"] = 2.0
+
+	read, ok := cache.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, map[string]interface{}{"n": 1.0}, read)
+	read.(map[string]interface{})["n"] = 3.0
+	again, _ := cache.Get("a")
+	assert.Equal(t, map[string]interface{}{"n": 1.0}, again)
+
+	var evicted interface{}
+	cache.SetCallback(func(key string, value interface{}) { evicted = value })
+	cache.Remove("a")
+	assert.Equal(t, map[string]interface{}{"n": 1.0}, evicted)
+}
+
+// Test serialized values are sized by their encoding and rejected when they cannot be encoded
+func TestLRU_WithSerialization_Rejects(t *testing.T) {
+	cache := cachify.NewLRU(10).WithCodec(cachify.JSONCodec{}).WithSerialization(true).WithMaxValueSize(8)
+
+	assert.NoError(t, cache.SetE("short", "abcdef"))
+	err := cache.SetE("long", "abcdefgh")
+	var sizeErr *cachify.ValueSizeError
+	assert.ErrorAs(t, err, &sizeErr)
+	assert.Equal(t, 10, sizeErr.Size)
+
+	assert.Error(t, cache.SetE("short", make(chan int)))
+	assert.False(t, cache.Contains("short"))
+}
+
+// Test counters keep working on serialized caches
+func TestLRU_WithSerialization_Increment(t *testing.T) {
+	for _, codec := range []cachify.Codec{cachify.GobCodec{}, cachify.JSONCodec{}} {
+		cache := cachify.NewLRU(10).WithCodec(codec).WithSerialization(true)
+
+		n, err := cache.Increment("hits", 1)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), n)
+		n, err = cache.Increment("hits", 2)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), n)
+
+		f, err := cache.IncrementFloat("score", 0.5)
+		assert.NoError(t, err)
+		f, err = cache.IncrementFloat("score", 0.25)
+		assert.NoError(t, err)
+		assert.Equal(t, 0.75, f)
+
+		value, ok := cache.Get("hits")
+		assert.True(t, ok)
+		assert.EqualValues(t, 3, value)
+	}
+}
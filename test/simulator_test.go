@@ -0,0 +1,28 @@
+package test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pnguyen215/cachify/simulator"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test Replay reports hit ratios per policy and capacity
+func TestSimulator_Replay(t *testing.T) {
+	trace := "# cyclic scan\n" + strings.Repeat("a b c d\n", 10)
+	results, err := simulator.Replay(strings.NewReader(trace), []int{3, 4}, simulator.LRU, simulator.MRU)
+	assert.NoError(t, err)
+	assert.Len(t, results, 4)
+
+	assert.Equal(t, "lru", results[0].Policy)
+	assert.Equal(t, 3, results[0].Capacity)
+	assert.Equal(t, 40, results[0].Requests)
+	assert.Equal(t, 0, results[0].Hits)
+	assert.Equal(t, 36, results[1].Hits)
+	assert.Greater(t, results[2].HitRatio(), results[0].HitRatio())
+
+	policy, ok := simulator.Lookup("CLOCK")
+	assert.True(t, ok)
+	assert.Equal(t, "clock", policy.Name)
+}
@@ -0,0 +1,39 @@
+package test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test oversized values bypass the cache with a typed error
+func TestLRU_WithMaxValueSize(t *testing.T) {
+	c := cachify.NewLRU(10).WithMaxValueSize(100)
+	c.Set("a", "small")
+	c.Set("b", "kept")
+
+	c.Set("a", strings.Repeat("x", 101))
+	assert.False(t, c.Contains("a"))
+	assert.Equal(t, 1, c.Len())
+
+	err := c.SetE("blob", make([]byte, 500))
+	assert.ErrorIs(t, err, cachify.ErrTooLarge)
+	var sizeErr *cachify.ValueSizeError
+	assert.True(t, errors.As(err, &sizeErr))
+	assert.Equal(t, 500, sizeErr.Size)
+	assert.Equal(t, 100, sizeErr.Max)
+
+	c.WithSizer(func(value interface{}) int { return 1 })
+	assert.NoError(t, c.SetE("blob", make([]byte, 500)))
+}
+
+// Test DefaultSizer estimates composite values
+func TestDefaultSizer(t *testing.T) {
+	assert.Equal(t, 5, cachify.DefaultSizer("hello"))
+	assert.Equal(t, 8, cachify.DefaultSizer(int64(1)))
+	assert.Equal(t, 6, cachify.DefaultSizer([]string{"ab", "cd", "ef"}))
+	assert.Equal(t, 4, cachify.DefaultSizer(map[string]string{"a": "b", "c": "d"}))
+}
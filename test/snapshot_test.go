@@ -0,0 +1,92 @@
+package test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test Snapshot and Restore preserve order, TTLs and tags
+func TestLRU_SnapshotRestore(t *testing.T) {
+	source := cachify.NewLRUExpiresLazy(5, time.Hour)
+	source.SetWithTags("a", "alpha", "letters")
+	source.Set("b", "beta")
+	source.Set("c", "gamma")
+	source.Set("dead", "x")
+	source.ExpandExpiry("dead", -2*time.Hour)
+	source.Get("a")
+
+	snapshot := source.Snapshot()
+	assert.Len(t, snapshot, 4)
+	assert.Equal(t, "a", snapshot[0].Key)
+
+	target := cachify.NewLRU(5)
+	target.Set("old", 1)
+	assert.Equal(t, 3, target.Restore(snapshot))
+	assert.False(t, target.Contains("old"))
+	assert.Equal(t, []string{"a", "c", "b"}, target.Keys())
+
+	remain, ok := target.PersistExpiry("b")
+	assert.True(t, ok)
+	assert.Greater(t, remain, 59*time.Minute)
+	assert.Equal(t, 1, target.InvalidateTag("letters"))
+
+	small := cachify.NewLRU(2)
+	assert.Equal(t, 2, small.Restore(snapshot))
+	assert.Equal(t, []string{"a", "c"}, small.Keys())
+}
+
+// Test Export pages through filtered entries in key order
+func TestLRU_Export(t *testing.T) {
+	cache := cachify.NewLRU(20)
+	for i := 0; i < 10; i++ {
+		cache.Set(fmt.Sprintf("user:%d", i), i)
+		cache.Set(fmt.Sprintf("order:%d", i), i)
+	}
+	cache.Get("user:0")
+
+	isUser := func(key string) bool { return strings.HasPrefix(key, "user:") }
+	page, total := cache.Export(2, 3, isUser)
+	assert.Equal(t, 10, total)
+	assert.Len(t, page, 3)
+	assert.Equal(t, "user:2", page[0].Key)
+	assert.Equal(t, 4, page[2].Value)
+
+	page, total = cache.Export(9, 5, isUser)
+	assert.Equal(t, 10, total)
+	assert.Equal(t, "user:9", page[0].Key)
+	assert.Len(t, page, 1)
+
+	page, total = cache.Export(50, 5, nil)
+	assert.Equal(t, 20, total)
+	assert.Empty(t, page)
+
+	page, _ = cache.Export(0, 0, nil)
+	assert.Len(t, page, 20)
+	assert.Equal(t, "order:0", page[0].Key)
+}
+
+// Test Restore releases the entries it discards, like Clear
+func TestLRU_Restore_ReleasesDiscarded(t *testing.T) {
+	var events []cachify.EventOp
+	cache := cachify.NewLRU(10).WithAutoClose(nil).WithEventListener(func(event cachify.Event) {
+		events = append(events, event.Op)
+	})
+	closed := &closeCounter{}
+	cache.Set("closer", closed)
+	var called string
+	cache.SetWithCallback("callback", "v", func(key string, value interface{}) {
+		called = key
+	})
+	events = nil
+
+	assert.Equal(t, 1, cache.Restore([]cachify.Entry{{Key: "fresh", Value: "x"}}))
+	assert.Eventually(t, func() bool { return closed.closed.Load() == 1 }, time.Second, time.Millisecond)
+	assert.Equal(t, "callback", called)
+	assert.Equal(t, []cachify.EventOp{cachify.EventClear}, events)
+	assert.True(t, cache.Contains("fresh"))
+}
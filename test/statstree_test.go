@@ -0,0 +1,176 @@
+package test
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test WithNamespaceStats attributes lookups and evictions to namespaces
+func TestLRU_NamespaceStats(t *testing.T) {
+	cache := cachify.NewLRU(3).WithNamespaceStats("a:", "b:")
+	tenantA := cache.Namespace("a:")
+
+	tenantA.Set("1", 1)
+	tenantA.Get("1")
+	tenantA.Get("2")
+	cache.Set("b:1", 1)
+	cache.Get("b:1")
+	cache.Get("c:1")
+
+	stats := tenantA.Stats()
+	assert.Equal(t, uint64(1), stats.Hits)
+	assert.Equal(t, uint64(1), stats.Misses)
+	assert.Equal(t, 1, stats.Len)
+
+	cache.Set("b:2", 2)
+	cache.Set("b:3", 3)
+	stats, ok := cache.NamespaceStats("a:")
+	assert.True(t, ok)
+	assert.Equal(t, uint64(1), stats.Evictions)
+
+	tree := cache.StatsTree()
+	assert.Equal(t, uint64(2), tree.Stats.Hits)
+	assert.Len(t, tree.Children, 2)
+	assert.Equal(t, "b:", tree.Children[1].Name)
+	assert.Equal(t, uint64(1), tree.Children[1].Stats.Hits)
+	assert.Equal(t, 3, tree.Children[1].Stats.Len)
+
+	_, ok = cache.NamespaceStats("c:")
+	assert.False(t, ok)
+}
+
+// Test Striped breaks its stats down per stripe
+func TestStriped_StatsTree(t *testing.T) {
+	cache := cachify.NewStriped(64, 4)
+	for _, key := range []string{"a", "b", "c", "d", "e"} {
+		cache.Set(key, key)
+		cache.Get(key)
+	}
+
+	perStripe := cache.StripeStats()
+	assert.Len(t, perStripe, 4)
+	var hits uint64
+	for _, stats := range perStripe {
+		hits += stats.Hits
+	}
+	assert.Equal(t, uint64(5), hits)
+
+	tree := cache.StatsTree()
+	assert.Equal(t, uint64(5), tree.Stats.Hits)
+	assert.Len(t, tree.Children, 4)
+	assert.Equal(t, "0", tree.Children[0].Name)
+}
+
+// Test Skew detects hot stripes and Rebalance spreads them with another seed
+func TestStriped_Rebalance(t *testing.T) {
+	cache := cachify.NewStriped(1024, 4)
+	stripe := cache.Stripe("seed")
+	var hot []string
+	for i := 0; len(hot) < 8; i++ {
+		key := fmt.Sprintf("hot-%d", i)
+		if cache.Stripe(key) == stripe {
+			hot = append(hot, key)
+		}
+	}
+	for i := 0; i < 40; i++ {
+		cache.Set(fmt.Sprintf("cold-%d", i), i)
+	}
+	for _, key := range hot {
+		cache.Set(key, key)
+		for i := 0; i < 50; i++ {
+			cache.Get(key)
+		}
+	}
+
+	report := cache.Skew(1.5)
+	assert.True(t, report.Skewed)
+	assert.Greater(t, report.AccessSkew, 2.0)
+	assert.NotZero(t, report.Suggested)
+	assert.Less(t, report.ProjectedSkew, report.AccessSkew)
+
+	_, reseeded := cache.Rebalance(1.5)
+	assert.True(t, reseeded)
+	assert.Equal(t, 48, cache.Len())
+	for _, key := range hot {
+		value, ok := cache.Get(key)
+		assert.True(t, ok)
+		assert.Equal(t, key, value)
+	}
+	assert.Less(t, cache.Skew(1.5).AccessSkew, report.AccessSkew)
+}
+
+// Test Reseed moves entries with their state while the cache stays in use
+func TestStriped_Reseed_KeepsState(t *testing.T) {
+	cache := cachify.NewStriped(1024, 8)
+	var evicted atomic.Int32
+	versions := make(map[string]uint64)
+	for i := 0; i < 64; i++ {
+		key := fmt.Sprintf("k-%d", i)
+		cache.Stripe(key).SetWithCallback(key, i, func(key string, value interface{}) {
+			evicted.Add(1)
+		})
+		versions[key] = cache.Stripe(key).Version(key)
+	}
+	for i := 0; i < 16; i++ {
+		cache.Set(fmt.Sprintf("other-%d", i), i)
+	}
+
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; ; i++ {
+			select {
+			case <-stop:
+				return
+			default:
+				cache.Get(fmt.Sprintf("k-%d", i%64))
+				cache.Set(fmt.Sprintf("other-%d", i%16), i)
+			}
+		}
+	}()
+	moved := 0
+	for seed := uint64(1); seed <= 4; seed++ {
+		moved += cache.Reseed(seed)
+	}
+	close(stop)
+	wg.Wait()
+
+	assert.Greater(t, moved, 0)
+	assert.Equal(t, int32(0), evicted.Load())
+	assert.Equal(t, 80, cache.Len())
+	for key, version := range versions {
+		_, got, ok := cache.Stripe(key).GetWithVersion(key)
+		assert.True(t, ok)
+		assert.Equal(t, version, got)
+		cache.Remove(key)
+	}
+	assert.Equal(t, int32(64), evicted.Load())
+}
+
+// Test Reseed mixes its seed into a custom hasher instead of replacing it
+func TestStriped_Reseed_CustomHasher(t *testing.T) {
+	byLength := func(key string) uint64 { return uint64(len(key)) }
+	cache := cachify.NewStriped(0, 4).WithHasher(byLength)
+	for i := 0; i < 16; i++ {
+		cache.Set(fmt.Sprintf("k%02d", i), i)
+	}
+	cache.Set("longer-key", 0)
+
+	cache.Reseed(7)
+	assert.Equal(t, 16, cache.Stripe("k00").Len())
+	assert.Equal(t, 17, cache.Len())
+	assert.Equal(t, uint64(7), cache.Skew(1.5).Seed)
+
+	cache.Reseed(0)
+	assert.Same(t, cache.Stripe("abc"), cache.Stripe("k00"))
+	assert.Same(t, cache.Stripe("xyz"), cache.Stripe("k00"))
+	assert.NotSame(t, cache.Stripe("ab"), cache.Stripe("k00"))
+	assert.Equal(t, 16, cache.Stripe("xyz").Len())
+}
@@ -0,0 +1,90 @@
+package test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test the striped cache spreads keys and respects the total capacity
+func TestStriped(t *testing.T) {
+	s := cachify.NewStriped(100, 6)
+	assert.Equal(t, 8, s.Stripes())
+	assert.Equal(t, 100, s.Capacity())
+
+	for i := 0; i < 1000; i++ {
+		s.Set(fmt.Sprintf("k%d", i), i)
+	}
+	assert.LessOrEqual(t, s.Len(), 100)
+	value, ok := s.Get("k999")
+	assert.True(t, ok)
+	assert.Equal(t, 999, value)
+	assert.Equal(t, uint64(900), s.Stats().Evictions)
+
+	s.Remove("k999")
+	assert.False(t, s.Contains("k999"))
+	s.Clear()
+	assert.Equal(t, 0, s.Len())
+}
+
+// Test a small capacity lowers the stripe count so the total stays exact
+func TestStriped_SmallCapacity(t *testing.T) {
+	s := cachify.NewStriped(3, 16)
+	assert.Equal(t, 2, s.Stripes())
+	assert.Equal(t, 3, s.Capacity())
+	for i := 0; i < 100; i++ {
+		s.Set(fmt.Sprintf("k%d", i), i)
+	}
+	assert.LessOrEqual(t, s.Len(), 3)
+	assert.Equal(t, 16, cachify.NewStriped(0, 16).Stripes())
+}
+
+// Test a custom hasher controls placement and concurrent use is safe
+func TestStriped_Hasher(t *testing.T) {
+	s := cachify.NewStriped(0, 4).WithHasher(func(key string) uint64 { return 0 })
+	s.Set("a", 1)
+	s.Set("b", 2)
+	assert.Equal(t, 2, s.Stripe("x").Len())
+
+	s = cachify.NewStriped(64, 8)
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 500; i++ {
+				key := fmt.Sprintf("%d-%d", g, i%40)
+				s.Set(key, i)
+				s.Get(key)
+			}
+		}(g)
+	}
+	wg.Wait()
+	assert.LessOrEqual(t, s.Len(), 64)
+}
+
+// Test the key transform routes variants of a key to one stripe and one entry, across a reseed
+func TestStriped_WithKeyTransform(t *testing.T) {
+	s := cachify.NewStriped(0, 16).WithKeyTransform(cachify.TrimLower)
+	for i := 0; i < 50; i++ {
+		s.Set(fmt.Sprintf(" Key%d ", i), i)
+	}
+	assert.Equal(t, 50, s.Len())
+	value, ok := s.Get("key7")
+	assert.True(t, ok)
+	assert.Equal(t, 7, value)
+	assert.True(t, s.Contains("KEY7"))
+	assert.Same(t, s.Stripe("key7"), s.Stripe(" Key7"))
+	assert.True(t, s.Stripe("Key7").Contains("Key7 "))
+
+	s.Reseed(42)
+	for i := 0; i < 50; i++ {
+		assert.True(t, s.Contains(fmt.Sprintf("KEY%d", i)))
+	}
+	s.Remove(" KEY7")
+	assert.False(t, s.Contains("key7"))
+	assert.Equal(t, 49, s.Len())
+}
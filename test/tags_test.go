@@ -0,0 +1,46 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test InvalidateTag removes only tagged entries
+func TestLRU_InvalidateTag(t *testing.T) {
+	cache := cachify.NewLRU(5)
+
+	cache.SetWithTags("page:1", "<p>1</p>", "user:42", "org:7")
+	cache.SetWithTags("page:2", "<p>2</p>", "user:42")
+	cache.SetWithTags("page:3", "<p>3</p>", "org:7")
+	cache.Set("plain", "value")
+
+	assert.Equal(t, 2, cache.InvalidateTag("user:42"))
+	assert.False(t, cache.Contains("page:1"))
+	assert.False(t, cache.Contains("page:2"))
+	assert.True(t, cache.Contains("page:3"))
+	assert.True(t, cache.Contains("plain"))
+
+	assert.Equal(t, 1, cache.InvalidateTag("org:7"))
+	assert.Equal(t, 0, cache.InvalidateTag("org:7"))
+}
+
+// Test retagging replaces tags and plain Set keeps them
+func TestLRU_Retag(t *testing.T) {
+	cache := cachify.NewLRU(2)
+
+	cache.SetWithTags("a", "alpha", "x")
+	cache.Set("a", "alpha2")
+	assert.Equal(t, []string{"x"}, cache.Tags("a"))
+
+	cache.SetWithTags("a", "alpha3", "y")
+	assert.Equal(t, 0, cache.InvalidateTag("x"))
+	assert.Equal(t, 1, cache.InvalidateTag("y"))
+
+	// Evicted entries leave the index
+	cache.SetWithTags("b", "beta", "z")
+	cache.Set("c", "gamma")
+	cache.Set("d", "delta")
+	assert.Equal(t, 0, cache.InvalidateTag("z"))
+}
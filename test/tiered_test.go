@@ -0,0 +1,109 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// memoryStore is a minimal in-memory Store used to exercise the tiered cache.
+type memoryStore struct {
+	mutex sync.Mutex
+	data  map[string]interface{}
+	ttls  map[string]time.Duration
+	err   error
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{
+		data: make(map[string]interface{}),
+		ttls: make(map[string]time.Duration),
+	}
+}
+
+func (s *memoryStore) Get(_ context.Context, key string) (interface{}, bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.err != nil {
+		return nil, false, s.err
+	}
+	value, ok := s.data[key]
+	return value, ok, nil
+}
+
+func (s *memoryStore) Set(_ context.Context, key string, value interface{}, ttl time.Duration) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.err != nil {
+		return s.err
+	}
+	s.data[key] = value
+	s.ttls[key] = ttl
+	return nil
+}
+
+func (s *memoryStore) Delete(_ context.Context, key string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.err != nil {
+		return s.err
+	}
+	delete(s.data, key)
+	delete(s.ttls, key)
+	return nil
+}
+
+// Test L2 fallback and promotion into L1
+func TestTiered_GetPromotes(t *testing.T) {
+	store := newMemoryStore()
+	store.data["a"] = "alpha"
+	cache := cachify.NewTiered(cachify.NewLRU(2), store)
+
+	assert.False(t, cache.L1().Contains("a"))
+	val, ok := cache.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, "alpha", val)
+	assert.True(t, cache.L1().Contains("a"))
+
+	_, ok = cache.Get("missing")
+	assert.False(t, ok)
+}
+
+// Test writes and removals reach both tiers
+func TestTiered_SetAndRemove(t *testing.T) {
+	store := newMemoryStore()
+	cache := cachify.NewTiered(cachify.NewLRU(2), store).WithTTL(time.Minute)
+
+	cache.Set("a", "alpha")
+	assert.Equal(t, "alpha", store.data["a"])
+	assert.Equal(t, time.Minute, store.ttls["a"])
+
+	cache.Remove("a")
+	assert.False(t, cache.L1().Contains("a"))
+	assert.NotContains(t, store.data, "a")
+}
+
+// Test L2 errors are surfaced through the context variants and the error callback
+func TestTiered_Errors(t *testing.T) {
+	store := newMemoryStore()
+	store.err = errors.New("unavailable")
+	var reported []string
+	cache := cachify.NewTiered(cachify.NewLRU(2), store).
+		WithErrorCallback(func(key string, err error) {
+			reported = append(reported, key)
+		})
+
+	_, _, err := cache.GetContext(context.Background(), "a")
+	assert.Error(t, err)
+
+	cache.Set("b", "beta")
+	assert.Equal(t, []string{"b"}, reported)
+	val, ok := cache.L1().Get("b")
+	assert.True(t, ok)
+	assert.Equal(t, "beta", val)
+}
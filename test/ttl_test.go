@@ -0,0 +1,208 @@
+package test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test WithMinTTL and WithMaxTTL clamp the expirations given to entries
+func TestLRU_TTLBounds(t *testing.T) {
+	cache := cachify.NewLRUExpires(10, time.Second).WithMinTTL(time.Minute).WithMaxTTL(time.Hour)
+	defer cache.Close()
+
+	cache.Set("a", 1)
+	remain, ok := cache.PersistExpiry("a")
+	assert.True(t, ok)
+	assert.InDelta(t, time.Minute.Seconds(), remain.Seconds(), 1)
+
+	cache.ExpandExpiry("a", 24*time.Hour)
+	remain, _ = cache.PersistExpiry("a")
+	assert.InDelta(t, time.Hour.Seconds(), remain.Seconds(), 1)
+
+	cache.ExpandExpiry("a", -48*time.Hour)
+	assert.True(t, cache.Contains("a"))
+}
+
+// Test NewLRUExpiresE and Validate reject nonsensical configurations
+func TestLRU_Validate(t *testing.T) {
+	_, err := cachify.NewLRUExpiresE(10, -time.Second)
+	assert.ErrorIs(t, err, cachify.ErrInvalidConfig)
+	_, err = cachify.NewLRUExpiresE(-1, time.Second)
+	assert.ErrorIs(t, err, cachify.ErrInvalidConfig)
+
+	cache, err := cachify.NewLRUExpiresE(10, time.Minute)
+	assert.NoError(t, err)
+	defer cache.Close()
+	assert.NoError(t, cache.Validate())
+
+	cache.WithMinTTL(time.Hour).WithMaxTTL(time.Minute)
+	assert.ErrorIs(t, cache.Validate(), cachify.ErrInvalidConfig)
+	cache.WithMinTTL(0)
+	assert.NoError(t, cache.Validate())
+
+	cache.WithCleanupInterval(-time.Second)
+	assert.ErrorIs(t, cache.Validate(), cachify.ErrInvalidConfig)
+}
+
+// Test a one-nanosecond expiry is accepted and runs a cleanup clamped to a bounded interval
+func TestLRU_Validate_TinyExpiry(t *testing.T) {
+	cache, err := cachify.NewLRUExpiresE(10, time.Nanosecond)
+	assert.NoError(t, err)
+	defer cache.Close()
+	assert.NoError(t, cache.Validate())
+	cache.Set("a", 1)
+	assert.Eventually(t, func() bool { return cache.Len() == 0 }, time.Second, time.Millisecond)
+
+	cache.WithCleanupInterval(time.Nanosecond)
+	assert.NoError(t, cache.Validate())
+}
+
+// Test SetWithDeadline expires entries at an absolute time
+func TestLRU_SetWithDeadline(t *testing.T) {
+	cache := cachify.NewLRUExpires(10, time.Hour)
+	defer cache.Close()
+
+	deadline := time.Now().Add(50 * time.Millisecond)
+	cache.SetWithDeadline("token", "abc", deadline)
+	state, ok := cache.GetStateByKey("token")
+	assert.True(t, ok)
+	assert.True(t, state.Expiration().Equal(deadline))
+
+	time.Sleep(60 * time.Millisecond)
+	_, ok = cache.Get("token")
+	assert.False(t, ok)
+
+	cache.Set("past", 1)
+	cache.SetWithDeadline("past", 2, time.Now().Add(-time.Second))
+	assert.False(t, cache.Contains("past"))
+
+	cache.WithMaxTTL(time.Minute)
+	cache.SetWithDeadline("far", 3, time.Now().Add(24*time.Hour))
+	remain, _ := cache.PersistExpiry("far")
+	assert.LessOrEqual(t, remain, time.Minute)
+}
+
+// Test Touch and Update keep a deadline set by SetWithDeadline, and a plain Set replaces it
+func TestLRU_SetWithDeadline_TouchUpdate(t *testing.T) {
+	cache := cachify.NewLRU(10)
+	deadline := time.Now().Add(time.Hour)
+	cache.SetWithDeadline("token", "abc", deadline)
+
+	assert.True(t, cache.Touch("token"))
+	state, _ := cache.GetStateByKey("token")
+	assert.True(t, state.Expiration().Equal(deadline))
+
+	cache.Update("token", "def")
+	state, _ = cache.GetStateByKey("token")
+	assert.True(t, state.Expiration().Equal(deadline))
+
+	_, err := cache.Increment("count", 1)
+	assert.NoError(t, err)
+	cache.SetWithDeadline("count", int64(1), deadline)
+	_, err = cache.Increment("count", 1)
+	assert.NoError(t, err)
+	assert.True(t, cache.Touch("count"))
+	state, _ = cache.GetStateByKey("count")
+	assert.True(t, state.Expiration().Equal(deadline))
+
+	cache.Set("token", "ghi")
+	assert.True(t, cache.Touch("token"))
+	state, _ = cache.GetStateByKey("token")
+	assert.True(t, state.Expiration().IsZero())
+}
+
+// Test SetPersistent exempts an entry from the cache-wide expiry
+func TestLRU_SetPersistent(t *testing.T) {
+	cache := cachify.NewLRUExpires(2, 20*time.Millisecond)
+	defer cache.Close()
+
+	cache.SetPersistent("countries", []string{"VN", "FR"})
+	cache.Set("session", 1)
+	time.Sleep(30 * time.Millisecond)
+	assert.False(t, cache.Contains("session"))
+	assert.True(t, cache.Contains("countries"))
+
+	// Rewriting keeps the exemption
+	cache.Set("countries", []string{"VN", "FR", "JP"})
+	time.Sleep(30 * time.Millisecond)
+	assert.True(t, cache.Contains("countries"))
+
+	// Capacity eviction still applies
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+	assert.False(t, cache.Contains("countries"))
+}
+
+// Test the persistent flag survives Snapshot, Restore, and snapshot files
+func TestLRU_SetPersistent_Snapshot(t *testing.T) {
+	cache := cachify.NewLRUExpires(10, time.Hour)
+	defer cache.Close()
+	cache.SetPersistent("countries", "VN")
+	cache.Set("session", 1)
+
+	snapshot := cache.Snapshot()
+	assert.True(t, snapshot[1].Persistent)
+	assert.False(t, snapshot[0].Persistent)
+
+	path := filepath.Join(t.TempDir(), "cache.snap")
+	assert.NoError(t, cache.SaveToFile(path))
+	restored := cachify.NewLRUExpires(10, time.Hour)
+	defer restored.Close()
+	_, err := restored.LoadFromFile(path)
+	assert.NoError(t, err)
+
+	// Rewriting a restored persistent entry keeps it exempt from the expiry
+	restored.Set("countries", "FR")
+	_, remain, ok := restored.GetWithTTL("countries")
+	assert.True(t, ok)
+	assert.Zero(t, remain)
+	_, remain, _ = restored.GetWithTTL("session")
+	assert.NotZero(t, remain)
+}
+
+// Test TTLs and TTLsOf report the remaining time-to-live of expiring keys
+func TestLRU_TTLs(t *testing.T) {
+	cache := cachify.NewLRUExpires(10, time.Hour)
+	defer cache.Close()
+
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+	cache.SetPersistent("c", 3)
+	cache.Set("d", 4)
+	cache.ExpandExpiry("d", -2*time.Hour)
+
+	ttls := cache.TTLs()
+	assert.Len(t, ttls, 2)
+	assert.InDelta(t, time.Hour.Seconds(), ttls["a"].Seconds(), 1)
+
+	ttls = cache.TTLsOf("b", "c", "missing")
+	assert.Len(t, ttls, 1)
+	assert.Contains(t, ttls, "b")
+}
+
+// Test GetWithTTL returns a value with its remaining time-to-live
+func TestLRU_GetWithTTL(t *testing.T) {
+	cache := cachify.NewLRUExpires(10, time.Hour)
+	defer cache.Close()
+
+	cache.Set("a", 1)
+	cache.SetPersistent("b", 2)
+
+	value, remain, ok := cache.GetWithTTL("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, value)
+	assert.InDelta(t, time.Hour.Seconds(), remain.Seconds(), 1)
+
+	value, remain, ok = cache.GetWithTTL("b")
+	assert.True(t, ok)
+	assert.Equal(t, 2, value)
+	assert.Zero(t, remain)
+
+	_, _, ok = cache.GetWithTTL("missing")
+	assert.False(t, ok)
+	assert.Equal(t, uint64(2), cache.Stats().Hits)
+}
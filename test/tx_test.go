@@ -0,0 +1,69 @@
+package test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test Apply commits staged writes together
+func TestLRU_Apply(t *testing.T) {
+	cache := cachify.NewLRU(10)
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+
+	cache.Apply(func(tx cachify.Tx) {
+		tx.Set("c", 3)
+		tx.Remove("a")
+		value, ok := tx.Get("c")
+		assert.True(t, ok)
+		assert.Equal(t, 3, value)
+		_, ok = tx.Get("a")
+		assert.False(t, ok)
+
+		// Nothing is visible before the commit
+		assert.False(t, cache.Contains("c"))
+		assert.True(t, cache.Contains("a"))
+
+		tx.Set("b", 20)
+		tx.Set("b", 200)
+	})
+
+	assert.False(t, cache.Contains("a"))
+	value, _ := cache.Get("b")
+	assert.Equal(t, 200, value)
+	value, _ = cache.Get("c")
+	assert.Equal(t, 3, value)
+}
+
+// Test Apply is atomic for concurrent readers
+func TestLRU_Apply_Atomic(t *testing.T) {
+	cache := cachify.NewLRU(10)
+	cache.Set("from", 100)
+	cache.Set("to", 0)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			cache.Apply(func(tx cachify.Tx) {
+				from, _ := tx.Get("from")
+				to, _ := tx.Get("to")
+				tx.Set("from", from.(int)-1)
+				tx.Set("to", to.(int)+1)
+			})
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		var sum int
+		cache.Range(func(key string, value interface{}) bool {
+			sum += value.(int)
+			return true
+		})
+		assert.Equal(t, 100, sum)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,80 @@
+package test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test versions increase on writes and guard SetIfVersion
+func TestLRU_SetIfVersion(t *testing.T) {
+	c := cachify.NewLRU(10)
+	assert.True(t, c.SetIfVersion("a", 1, 0))
+	assert.False(t, c.SetIfVersion("a", 1, 0))
+
+	value, version, ok := c.GetWithVersion("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, value)
+
+	c.Set("a", 2)
+	assert.False(t, c.SetIfVersion("a", 3, version))
+	assert.True(t, c.SetIfVersion("a", 3, c.Version("a")))
+
+	state, _ := c.GetStateByKey("a")
+	assert.Equal(t, c.Version("a"), state.Version())
+
+	old := c.Version("a")
+	c.Remove("a")
+	c.Set("a", 4)
+	assert.Greater(t, c.Version("a"), old)
+	assert.Equal(t, uint64(0), c.Version("missing"))
+}
+
+// Test concurrent read-modify-write loops lose no updates
+func TestLRU_SetIfVersionConcurrent(t *testing.T) {
+	c := cachify.NewLRU(10)
+	c.Set("n", 0)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				for {
+					value, version, _ := c.GetWithVersion("n")
+					if c.SetIfVersion("n", value.(int)+1, version) {
+						break
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	value, _ := c.Get("n")
+	assert.Equal(t, 800, value)
+}
+
+// Test Touch keeps the version, while Increment advances it
+func TestLRU_Touch_KeepsVersion(t *testing.T) {
+	cache := cachify.NewLRUExpires(10, time.Hour)
+	defer cache.Close()
+
+	cache.Set("a", 1)
+	_, version, ok := cache.GetWithVersion("a")
+	assert.True(t, ok)
+	assert.True(t, cache.Touch("a"))
+	assert.True(t, cache.SetIfVersion("a", 2, version))
+
+	cache.Set("n", 1)
+	version = cache.Version("n")
+	_, err := cache.Increment("n", 1)
+	assert.NoError(t, err)
+	assert.False(t, cache.SetIfVersion("n", 5, version))
+
+	_, _, ok = cache.GetWithVersion("missing")
+	assert.False(t, ok)
+	assert.Equal(t, uint64(1), cache.Stats().Misses)
+}
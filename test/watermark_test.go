@@ -0,0 +1,35 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test an overflowing cache is evicted down to the low watermark in one batch
+func TestLRU_WithWatermarks(t *testing.T) {
+	cache := cachify.NewLRU(100).WithWatermarks(6, 10)
+	low, high := cache.Watermarks()
+	assert.Equal(t, 6, low)
+	assert.Equal(t, 10, high)
+
+	for i := 0; i < 10; i++ {
+		cache.Set(fmt.Sprintf("k%d", i), i)
+	}
+	assert.Equal(t, 10, cache.Len())
+	cache.Set("k10", 10)
+	assert.Equal(t, 6, cache.Len())
+	assert.False(t, cache.Contains("k4"))
+	assert.True(t, cache.Contains("k5"))
+	assert.Equal(t, uint64(5), cache.Stats().Evictions)
+
+	// Shrinking below the low watermark evicts exactly and stops batching
+	cache.SetCapacity(4)
+	assert.Equal(t, 4, cache.Len())
+	low, _ = cache.Watermarks()
+	assert.Equal(t, 0, low)
+	cache.Set("k11", 11)
+	assert.Equal(t, 4, cache.Len())
+}
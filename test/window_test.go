@@ -0,0 +1,38 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test windowed stats only count recent traffic
+func TestLRU_WindowStats(t *testing.T) {
+	cache := cachify.NewLRU(1)
+	assert.Equal(t, cachify.Stats{}, cache.WindowStats())
+
+	cache.WithStatsWindow(50*time.Millisecond, 2)
+	cache.Set("a", 1)
+	cache.Get("a")
+	cache.Get("b")
+	cache.Set("c", 3)
+
+	stats := cache.WindowStats()
+	assert.Equal(t, uint64(1), stats.Hits)
+	assert.Equal(t, uint64(1), stats.Misses)
+	assert.Equal(t, uint64(1), stats.Evictions)
+	assert.Equal(t, 1, stats.Len)
+	assert.Equal(t, 0.5, stats.HitRatio())
+
+	time.Sleep(150 * time.Millisecond)
+	cache.Get("c")
+	stats = cache.WindowStats()
+	assert.Equal(t, uint64(1), stats.Hits)
+	assert.Equal(t, uint64(0), stats.Misses)
+	assert.Equal(t, uint64(2), cache.Stats().Hits)
+
+	cache.WithStatsWindow(0, 0)
+	assert.Equal(t, uint64(0), cache.WindowStats().Hits)
+}
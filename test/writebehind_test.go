@@ -0,0 +1,74 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test dirty entries are coalesced and drained on Close
+func TestWriteBehind_DrainOnClose(t *testing.T) {
+	store := newMemoryStore()
+	cache := cachify.NewWriteBehind(cachify.NewLRU(4), store, time.Hour, 0)
+
+	cache.Set("a", "alpha")
+	cache.Set("a", "alpha2")
+	cache.Set("b", "beta")
+	cache.Remove("b")
+	assert.Equal(t, 2, cache.Pending())
+	assert.Empty(t, store.data)
+
+	assert.NoError(t, cache.Close())
+	assert.Equal(t, "alpha2", store.data["a"])
+	assert.NotContains(t, store.data, "b")
+	assert.Equal(t, 0, cache.Pending())
+}
+
+// Test reaching the batch size triggers a flush before the interval
+func TestWriteBehind_BatchSize(t *testing.T) {
+	store := newMemoryStore()
+	cache := cachify.NewWriteBehind(cachify.NewLRU(4), store, time.Hour, 2)
+	defer cache.Close()
+
+	cache.Set("a", "alpha")
+	cache.Set("b", "beta")
+	assert.Eventually(t, func() bool {
+		return cache.Pending() == 0
+	}, time.Second, 10*time.Millisecond)
+
+	val, ok, _ := store.Get(context.Background(), "b")
+	assert.True(t, ok)
+	assert.Equal(t, "beta", val)
+}
+
+// Test a zero interval falls back to the default flush interval instead of panicking
+func TestWriteBehind_DefaultInterval(t *testing.T) {
+	store := newMemoryStore()
+	cache := cachify.NewWriteBehind(cachify.NewLRU(4), store, 0, 0)
+	defer cache.Close()
+
+	cache.Set("a", "alpha")
+	assert.Eventually(t, func() bool {
+		return cache.Pending() == 0
+	}, 3*time.Second, 10*time.Millisecond)
+}
+
+// Test failures are retried and reported
+func TestWriteBehind_Retry(t *testing.T) {
+	store := newMemoryStore()
+	store.err = errors.New("unavailable")
+	var reported []string
+	cache := cachify.NewWriteBehind(cachify.NewLRU(4), store, time.Hour, 0).
+		WithRetry(2, time.Millisecond).
+		WithErrorCallback(func(key string, err error) {
+			reported = append(reported, key)
+		})
+
+	cache.Set("a", "alpha")
+	assert.Error(t, cache.Close())
+	assert.Equal(t, []string{"a"}, reported)
+}
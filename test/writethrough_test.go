@@ -0,0 +1,48 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/pnguyen215/cachify"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test writes and removals are mirrored to the store
+func TestWriteThrough_Mirrors(t *testing.T) {
+	store := newMemoryStore()
+	cache := cachify.NewWriteThrough(cachify.NewLRU(2), store)
+
+	cache.Set("a", "alpha")
+	assert.Equal(t, "alpha", store.data["a"])
+	val, ok := cache.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, "alpha", val)
+
+	cache.Update("a", "updated")
+	assert.Equal(t, "updated", store.data["a"])
+
+	cache.Remove("a")
+	assert.False(t, cache.Cache().Contains("a"))
+	assert.NotContains(t, store.data, "a")
+}
+
+// Test store failures keep the cache untouched and are surfaced
+func TestWriteThrough_Errors(t *testing.T) {
+	store := newMemoryStore()
+	store.err = errors.New("unavailable")
+	var reported []string
+	cache := cachify.NewWriteThrough(cachify.NewLRU(2), store).
+		WithErrorCallback(func(key string, err error) {
+			reported = append(reported, key)
+		})
+
+	err := cache.SetContext(context.Background(), "a", "alpha")
+	assert.Error(t, err)
+	assert.False(t, cache.Cache().Contains("a"))
+
+	cache.Set("b", "beta")
+	assert.Equal(t, []string{"b"}, reported)
+	assert.False(t, cache.Cache().Contains("b"))
+}
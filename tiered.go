@@ -0,0 +1,175 @@
+package cachify
+
+import (
+	"context"
+	"time"
+)
+
+// NewTiered creates a new two-tier cache composed of an in-memory LRU and a backing store.
+//
+// Parameters:
+//   - l1: The in-memory LRU cache checked first on every read.
+//   - l2: The backing store consulted when a key is missing from L1.
+//
+// Returns:
+//   - A pointer to an initialized Tiered cache.
+//
+// Details:
+//   - Values found in L2 are promoted back into L1 so subsequent reads are served from memory.
+//   - Writes go to both tiers; L2 writes use the L1 expiration unless overridden with WithTTL.
+func NewTiered(l1 *LRU, l2 Store) *Tiered {
+	return &Tiered{
+		l1: l1,
+		l2: l2,
+	}
+}
+
+// WithTTL sets the time-to-live used when writing entries to the backing store.
+//
+// Parameters:
+//   - ttl: The duration after which L2 entries expire. Zero falls back to the L1 expiration.
+//
+// Returns:
+//   - The Tiered cache, for chaining.
+func (t *Tiered) WithTTL(ttl time.Duration) *Tiered {
+	t.ttl = ttl
+	return t
+}
+
+// WithErrorCallback sets the callback invoked when an L2 operation fails
+// inside a method that does not return an error (Get, Set, Remove).
+//
+// Parameters:
+//   - callback: A function of type `OnErrorCallback`.
+//
+// Returns:
+//   - The Tiered cache, for chaining.
+func (t *Tiered) WithErrorCallback(callback OnErrorCallback) *Tiered {
+	t.onError = callback
+	return t
+}
+
+// L1 returns the in-memory tier.
+func (t *Tiered) L1() *LRU {
+	return t.l1
+}
+
+// L2 returns the backing store tier.
+func (t *Tiered) L2() Store {
+	return t.l2
+}
+
+// Get retrieves the value associated with a given key, checking L1 before L2.
+//
+// Parameters:
+//   - key: The key whose value is to be retrieved.
+//
+// Returns:
+//   - The value associated with the key, or nil if the key is not found in either tier.
+//   - A boolean indicating whether the key exists.
+//
+// Details:
+//   - Uses a background context for the L2 lookup; L2 errors are reported to the error callback
+//     and treated as a miss.
+func (t *Tiered) Get(key string) (value interface{}, ok bool) {
+	value, ok, err := t.GetContext(context.Background(), key)
+	if err != nil {
+		t.notify(key, err)
+	}
+	return value, ok
+}
+
+// GetContext retrieves the value associated with a given key, checking L1 before L2.
+//
+// Parameters:
+//   - ctx: The context passed to the backing store.
+//   - key: The key whose value is to be retrieved.
+//
+// Returns:
+//   - The value associated with the key, or nil if the key is not found in either tier.
+//   - A boolean indicating whether the key exists.
+//   - An error if the L2 lookup failed.
+//
+// Details:
+//   - On an L2 hit the value is promoted into L1.
+func (t *Tiered) GetContext(ctx context.Context, key string) (value interface{}, ok bool, err error) {
+	if value, ok := t.l1.Get(key); ok {
+		return value, true, nil
+	}
+	value, ok, err = t.l2.Get(ctx, key)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	// Promote the value back into the in-memory tier
+	t.l1.Set(key, value)
+	return value, true, nil
+}
+
+// Set inserts or updates a key-value pair in both tiers.
+//
+// Parameters:
+//   - key: The key to be added or updated.
+//   - value: The value to be associated with the key.
+//
+// Details:
+//   - L2 errors are reported to the error callback.
+func (t *Tiered) Set(key string, value interface{}) {
+	if err := t.SetContext(context.Background(), key, value); err != nil {
+		t.notify(key, err)
+	}
+}
+
+// SetContext inserts or updates a key-value pair in both tiers.
+//
+// Parameters:
+//   - ctx: The context passed to the backing store.
+//   - key: The key to be added or updated.
+//   - value: The value to be associated with the key.
+//
+// Returns:
+//   - An error if the L2 write failed. The L1 write is kept regardless.
+func (t *Tiered) SetContext(ctx context.Context, key string, value interface{}) error {
+	t.l1.Set(key, value)
+	return t.l2.Set(ctx, key, value, t.storeTTL())
+}
+
+// Remove deletes a key from both tiers.
+//
+// Parameters:
+//   - key: The key to be removed.
+//
+// Details:
+//   - L2 errors are reported to the error callback.
+func (t *Tiered) Remove(key string) {
+	if err := t.RemoveContext(context.Background(), key); err != nil {
+		t.notify(key, err)
+	}
+}
+
+// RemoveContext deletes a key from both tiers.
+//
+// Parameters:
+//   - ctx: The context passed to the backing store.
+//   - key: The key to be removed.
+//
+// Returns:
+//   - An error if the L2 delete failed. The key is removed from L1 regardless.
+func (t *Tiered) RemoveContext(ctx context.Context, key string) error {
+	t.l1.Remove(key)
+	return t.l2.Delete(ctx, key)
+}
+
+// storeTTL returns the time-to-live used for L2 writes.
+func (t *Tiered) storeTTL() time.Duration {
+	if t.ttl > 0 {
+		return t.ttl
+	}
+	return t.l1.expiry()
+}
+
+// notify invokes the error callback, if any.
+func (t *Tiered) notify(key string, err error) {
+	if t.onError != nil {
+		t.onError(key, err)
+	}
+}
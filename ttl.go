@@ -0,0 +1,212 @@
+package cachify
+
+import (
+	"fmt"
+	"time"
+)
+
+// NewLRUExpiresE creates a new LRU cache with a time-to-live for entries, rejecting nonsensical settings.
+//
+// Parameters:
+//   - capacity: The maximum number of items the cache can hold. Zero means unbounded.
+//   - expiry: The expiration duration for each cache entry. It must be positive.
+//
+// Returns:
+//   - A pointer to an initialized LRU cache, as NewLRUExpires would create.
+//   - An error matching ErrInvalidConfig if the capacity is negative or the expiry is not positive,
+//     in which case no cleanup goroutine is started.
+//
+// Details:
+//   - However short the expiry, the background cleanup runs at most once per millisecond.
+func NewLRUExpiresE(capacity int, expiry time.Duration) (*LRU, error) {
+	if capacity < 0 {
+		return nil, fmt.Errorf("%w: negative capacity %d", ErrInvalidConfig, capacity)
+	}
+	if expiry <= 0 {
+		return nil, fmt.Errorf("%w: expiry %v is not positive", ErrInvalidConfig, expiry)
+	}
+	return NewLRUExpires(capacity, expiry), nil
+}
+
+// Validate checks the cache's configuration for settings that are accepted silently but make no sense.
+//
+// Returns:
+//   - nil if the configuration is consistent, or an error matching ErrInvalidConfig describing the
+//     first problem found.
+//
+// Details:
+//   - Reports a negative capacity, expiry, cleanup interval, cleanup budget, or TTL bound, a minimum
+//     TTL above the maximum TTL, and a low watermark above the capacity.
+//   - A zero cleanup interval is valid: it is the unset value, restored by WithCleanupInterval(0), and
+//     derives the interval from the expiry. Any interval is raised to at least one millisecond.
+//   - Call it once the cache is configured, e.g. at startup, to fail fast on a bad configuration.
+func (c *LRU) Validate() error {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	switch {
+	case c.capacity < 0:
+		return fmt.Errorf("%w: negative capacity %d", ErrInvalidConfig, c.capacity)
+	case c.expiration < 0:
+		return fmt.Errorf("%w: negative expiry %v", ErrInvalidConfig, c.expiration)
+	case c.cleanupInterval < 0:
+		return fmt.Errorf("%w: negative cleanup interval %v", ErrInvalidConfig, c.cleanupInterval)
+	case c.sweepEntries < 0 || c.sweepDuration < 0:
+		return fmt.Errorf("%w: negative cleanup budget", ErrInvalidConfig)
+	case c.minTTL < 0 || c.maxTTL < 0:
+		return fmt.Errorf("%w: negative TTL bound", ErrInvalidConfig)
+	case c.maxTTL > 0 && c.minTTL > c.maxTTL:
+		return fmt.Errorf("%w: minimum TTL %v above maximum TTL %v", ErrInvalidConfig, c.minTTL, c.maxTTL)
+	case c.capacity > 0 && c.lowWatermark > c.capacity:
+		return fmt.Errorf("%w: low watermark %d above capacity %d", ErrInvalidConfig, c.lowWatermark, c.capacity)
+	}
+	return nil
+}
+
+// WithMinTTL sets the shortest time-to-live an expiring entry can be given.
+//
+// Parameters:
+//   - ttl: The lower bound. Zero or less removes it.
+//
+// Returns:
+//   - The LRU cache, for chaining.
+//
+// Details:
+//   - Expirations computed from now on are raised to now plus the bound: writes under a shorter
+//     cache-wide expiry, and ExpandExpiry calls that would leave less time.
+//   - Entries that never expire are not affected; existing expirations are left as they are.
+func (c *LRU) WithMinTTL(ttl time.Duration) *LRU {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.minTTL = max(ttl, 0)
+	return c
+}
+
+// WithMaxTTL sets the longest time-to-live an expiring entry can be given.
+//
+// Parameters:
+//   - ttl: The upper bound. Zero or less removes it.
+//
+// Returns:
+//   - The LRU cache, for chaining.
+//
+// Details:
+//   - Expirations computed from now on are lowered to now plus the bound, e.g. when ExpandExpiry
+//     would extend an entry beyond it.
+//   - Entries that never expire are not affected; existing expirations are left as they are.
+//   - When both bounds are set and conflict, the maximum wins (see Validate).
+func (c *LRU) WithMaxTTL(ttl time.Duration) *LRU {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.maxTTL = max(ttl, 0)
+	return c
+}
+
+// clampExpiry bounds an expiration time to the TTL bounds, measured from now.
+//
+// Details:
+//   - Must be called with the lock held. The zero time, meaning no expiration, is returned as is.
+func (c *LRU) clampExpiry(expiration time.Time) time.Time {
+	if expiration.IsZero() || (c.minTTL == 0 && c.maxTTL == 0) {
+		return expiration
+	}
+	now := time.Now()
+	if c.minTTL > 0 && expiration.Before(now.Add(c.minTTL)) {
+		expiration = now.Add(c.minTTL)
+	}
+	if c.maxTTL > 0 && expiration.After(now.Add(c.maxTTL)) {
+		expiration = now.Add(c.maxTTL)
+	}
+	return expiration
+}
+
+// TTLs returns the remaining time-to-live of every expiring key, in one lock acquisition.
+//
+// Returns:
+//   - A map from key to the time left until it expires.
+//
+// Details:
+//   - Like PersistExpiry, keys that never expire are left out, as are expired keys not yet removed.
+//   - Uses read locking and does not modify the order of items, so it suits monitoring endpoints.
+func (c *LRU) TTLs() map[string]time.Duration {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	now := time.Now()
+	ttls := make(map[string]time.Duration)
+	for key, element := range c.cache {
+		if remain, ok := remaining(element.Value.(*entries), now); ok {
+			ttls[key] = remain
+		}
+	}
+	return ttls
+}
+
+// TTLsOf returns the remaining time-to-live of the given keys, in one lock acquisition.
+//
+// Parameters:
+//   - keys: The keys to report.
+//
+// Returns:
+//   - A map from key, as given, to the time left until it expires. Keys that are absent, expired,
+//     or never expire are left out.
+func (c *LRU) TTLsOf(keys ...string) map[string]time.Duration {
+	normalized := make([]string, len(keys))
+	for i, key := range keys {
+		normalized[i] = c.normalizeKey(key)
+	}
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	now := time.Now()
+	ttls := make(map[string]time.Duration, len(keys))
+	for i, key := range normalized {
+		if element, exists := c.cache[key]; exists {
+			if remain, ok := remaining(element.Value.(*entries), now); ok {
+				ttls[keys[i]] = remain
+			}
+		}
+	}
+	return ttls
+}
+
+// remaining returns the time left until an entry expires, and false if it never expires or already has.
+func remaining(entry *entries, now time.Time) (time.Duration, bool) {
+	if entry.expiration.IsZero() || expired(entry, now) {
+		return 0, false
+	}
+	return entry.expiration.Sub(now), true
+}
+
+// GetWithTTL retrieves the value of a key together with its remaining time-to-live.
+//
+// Parameters:
+//   - key: The key whose value is to be retrieved.
+//
+// Returns:
+//   - The value associated with the key, or nil if the key is not found or has expired.
+//   - The time left until the entry expires, or 0 if it never expires.
+//   - A boolean indicating whether a live value was returned.
+//
+// Details:
+//   - Behaves like Get, updating recency and hit statistics, but reads the value and its expiration
+//     under a single lock acquisition, so the freshness reported is the one of the value returned.
+//   - Useful to decide on a background refresh when the remaining time is short.
+func (c *LRU) GetWithTTL(key string) (value interface{}, remain time.Duration, ok bool) {
+	if l := c.latency.Load(); l != nil {
+		defer l.get.observe(time.Now())
+	}
+	key = c.normalizeKey(key)
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	element := c.cache[key]
+	if element == nil {
+		c.countNamespaces(key, (*counters).miss)
+	}
+	value, ok = c.access(element)
+	if !ok {
+		return nil, 0, false
+	}
+	remain, _ = remaining(element.Value.(*entries), time.Now())
+	return value, remain, true
+}
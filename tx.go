@@ -0,0 +1,68 @@
+package cachify
+
+import "container/list"
+
+// Apply stages several writes and commits them atomically.
+//
+// Parameters:
+//   - fn: A function staging writes through the Tx it receives.
+//
+// Details:
+//   - fn runs without any lock held: its writes are only staged, and its reads see the staged writes
+//     on top of the cache's current contents, so fn may call other cache methods freely.
+//   - Once fn returns, the staged writes are committed in the order their keys were first staged,
+//     under a single acquisition of the write lock; readers observe all of them or none.
+//   - The capacity is enforced once, after the whole transaction, so it may evict entries it just wrote
+//     if it writes more keys than the cache can hold.
+//   - The Tx must not be used once fn has returned.
+func (c *LRU) Apply(fn func(tx Tx)) {
+	t := &tx{cache: c, staged: make(map[string]int)}
+	fn(t)
+	if len(t.ops) == 0 {
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	var written *list.Element
+	for _, op := range t.ops {
+		if op.remove {
+			if element, exists := c.cache[op.key]; exists {
+				c.evict(element)
+			}
+			continue
+		}
+		c.set(op.key, op.value)
+		c.enforceQuotas(op.key)
+		written = c.cache[op.key]
+	}
+	c.enforceCapacity(written)
+}
+
+// Get returns the staged value of a key, or its value in the cache if the transaction has not written it.
+func (t *tx) Get(key string) (value interface{}, ok bool) {
+	if i, exists := t.staged[t.cache.normalizeKey(key)]; exists {
+		op := t.ops[i]
+		return op.value, !op.remove
+	}
+	return t.cache.Get(key)
+}
+
+// Set stages a key-value pair, replacing any write staged earlier for the key.
+func (t *tx) Set(key string, value interface{}) {
+	t.stage(txOp{key: t.cache.normalizeKey(key), value: value})
+}
+
+// Remove stages the removal of a key, replacing any write staged earlier for the key.
+func (t *tx) Remove(key string) {
+	t.stage(txOp{key: t.cache.normalizeKey(key), remove: true})
+}
+
+// stage records a write, keeping a single write per key.
+func (t *tx) stage(op txOp) {
+	if i, exists := t.staged[op.key]; exists {
+		t.ops[i] = op
+		return
+	}
+	t.staged[op.key] = len(t.ops)
+	t.ops = append(t.ops, op)
+}
@@ -2,7 +2,11 @@ package cachify
 
 import (
 	"container/list"
+	"context"
+	"crypto/cipher"
+	"io"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -23,27 +27,372 @@ type OnCallback func(key string, value interface{})
 //   - onEvict: An optional callback function invoked when an item is evicted.
 //   - expiration: The duration for which entries are valid in the cache. Zero means no expiration.
 //   - stopCleanup: A channel used to signal stopping of the background cleanup goroutine.
+//   - tags: An index from tag to the keys carrying it, created on first use.
+//   - prefixes: A trie of all keys supporting prefix-scoped operations, built on first use.
+//   - quotas: The entry quota and recency order of each namespace prefix with a quota.
+//   - closed: Whether Close has been called.
+//   - cleanupInterval: The time between background cleanup runs. Zero means half the expiration.
+//   - lazy: Whether expired entries are only removed on access or PurgeExpired, without a background cleanup.
+//   - sweepEntries: The maximum number of entries examined per bounded cleanup sweep.
+//   - sweepDuration: The maximum time spent per bounded cleanup sweep.
+//   - sweepCursor: The element where the next bounded cleanup sweep resumes.
+//   - snapshotPath: The file the cache is periodically and finally persisted to. Empty disables auto-snapshots.
+//   - stopSnapshot: A channel used to signal stopping of the background snapshot goroutine.
+//   - onSnapshotError: An optional callback invoked when an automatic snapshot fails.
+//   - codec: The codec used to encode values in snapshot files. Nil means GobCodec.
+//   - compressor: The compressor applied to large string and []byte values. Nil disables compression.
+//   - compressThreshold: The minimum value size, in bytes, that is compressed.
+//   - aead: The cipher sealing snapshot files. Nil writes them in plaintext.
+//   - sizer: The function measuring values. Nil means DefaultSizer.
+//   - maxValueSize: The largest value size accepted, in bytes. Zero or less means unlimited.
+//   - policy: The victim selection used by capacity-based eviction.
+//   - ghost: The keys recently evicted for capacity, used to detect an undersized cache. Nil disables it.
+//   - stats: The hit, miss, eviction, and expiration counters.
+//   - version: The last version handed out; every write takes the next one, so versions are never reused.
+//   - keyTransform: An optional function canonicalizing every key passed to the cache.
+//   - reads: An optional buffer recording Get accesses, so hits only need the read lock.
+//   - latency: Optional latency histograms for Get, Set, and Load, swapped atomically so recording needs no lock.
+//   - onEvent: An optional listener notified of every change to the cache's contents.
+//   - onExpire: An optional callback invoked when an entry leaves because its TTL elapsed.
+//   - onPanic: An optional callback notified when a user callback panics.
+//   - closer: An optional releaser closing values that leave the cache.
+//   - copier: An optional function copying values as they enter and leave the cache.
+//   - serialize: Whether values are stored encoded with the codec rather than by reference.
+//   - stopPressure: A channel used to signal stopping of the memory-pressure goroutine.
+//   - lowWatermark: The size an overflowing cache is evicted down to, when below the capacity. Zero disables it.
+//   - onStale: An optional internal hook receiving expired values, used by Loading.WithStaleIfError.
+//   - indexer: An optional function extracting the secondary index values of a value.
+//   - index: The secondary index from index value to the keys matching it, created on first use.
+//   - hashValues: Whether the index from value content hash to keys is maintained.
+//   - hashes: The index from value content hash to the keys storing it, created on first use.
+//   - dedup: Whether identical serialized values are stored once.
+//   - interned: The shared payloads keyed by content hash, created on first use.
+//   - dedupSaved: The number of payload bytes not stored thanks to deduplication.
+//   - minTTL: The shortest time-to-live an expiring entry can be given. Zero means no bound.
+//   - maxTTL: The longest time-to-live an expiring entry can be given. Zero means no bound.
+//   - namespaces: The usage counters of the tracked namespaces keyed by prefix, replaced as a whole
+//     when a namespace is added.
 type LRU struct {
-	capacity    int
-	cache       map[string]*list.Element
-	list        *list.List
-	mutex       sync.RWMutex
-	onEvict     OnCallback
-	expiration  time.Duration
-	stopCleanup chan struct{}
+	capacity          int
+	cache             map[string]*list.Element
+	list              *list.List
+	mutex             sync.RWMutex
+	onEvict           OnCallback
+	expiration        time.Duration
+	stopCleanup       chan struct{}
+	tags              map[string]map[string]struct{}
+	prefixes          *prefixNode
+	quotas            map[string]*quota
+	closed            bool
+	cleanupInterval   time.Duration
+	lazy              bool
+	sweepEntries      int
+	sweepDuration     time.Duration
+	sweepCursor       *list.Element
+	snapshotPath      string
+	stopSnapshot      chan struct{}
+	onSnapshotError   OnErrorCallback
+	codec             Codec
+	compressor        Compressor
+	compressThreshold int
+	aead              cipher.AEAD
+	sizer             Sizer
+	maxValueSize      int
+	policy            EvictionPolicy
+	ghost             *ghostList
+	stats             counters
+	version           uint64
+	keyTransform      KeyTransform
+	reads             *readBuffer
+	latency           atomic.Pointer[latencies]
+	onEvent           EventListener
+	onExpire          OnCallback
+	onPanic           OnErrorCallback
+	closer            *valueCloser
+	copier            Copier
+	serialize         bool
+	stopPressure      chan struct{}
+	lowWatermark      int
+	onStale           func(key string, value interface{}, expiration time.Time)
+	indexer           Indexer
+	index             map[string]map[string]struct{}
+	hashValues        bool
+	hashes            map[uint64]map[string]struct{}
+	dedup             bool
+	interned          map[uint64]*internedValue
+	dedupSaved        int
+	minTTL            time.Duration
+	maxTTL            time.Duration
+	namespaces        atomic.Pointer[map[string]*counters]
+}
+
+// internedValue represents a payload shared by every entry storing identical bytes.
+//
+// Fields:
+//   - data: The shared payload.
+//   - refs: The number of entries holding it.
+type internedValue struct {
+	data []byte
+	refs int
+}
+
+// readBuffer represents the accesses recorded by Get while holding only the read lock.
+// Accesses are appended to per-processor batches and applied to the recency list in bulk.
+//
+// Fields:
+//   - size: The number of accesses in a batch before it is drained.
+//   - pool: The pool of batches; the pool hands each goroutine a batch it owns exclusively.
+//   - dropped: The number of accesses discarded because the write lock was busy.
+type readBuffer struct {
+	size    int
+	pool    sync.Pool
+	dropped atomic.Uint64
 }
 
-// state represents metadata about the least recently used item.
+// readAccess represents one buffered access.
+//
+// Fields:
+//   - element: The list element that was read.
+//   - time: The time of the read.
+type readAccess struct {
+	element *list.Element
+	time    time.Time
+}
+
+// ghostList represents the keys recently evicted for capacity, without their values.
+// A write to a ghost key means the cache would have hit had it been larger.
+//
+// Fields:
+//   - size: The maximum number of keys remembered.
+//   - keys: The ghost keys, most recently evicted first.
+//   - index: A map from key to its element in keys.
+//   - hits: The number of writes to ghost keys since the list was enabled.
+//   - minCapacity: The lower bound for adaptive capacity. Zero disables adaptation.
+//   - maxCapacity: The upper bound for adaptive capacity.
+//   - inserts: The number of new keys inserted in the current adaptation window.
+//   - windowHits: The number of ghost hits in the current adaptation window.
+type ghostList struct {
+	size        int
+	keys        *list.List
+	index       map[string]*list.Element
+	hits        uint64
+	minCapacity int
+	maxCapacity int
+	inserts     int
+	windowHits  int
+}
+
+// Cache represents the operations shared by every cache implementation, so application code
+// can swap implementations through configuration.
+//
+// Methods:
+//   - Get: Retrieves the value of a key and whether it exists.
+//   - Set: Inserts or updates a key-value pair.
+//   - Remove: Deletes a key.
+//   - Len: Returns the number of entries.
+//   - Clear: Removes every entry.
+//   - Contains: Reports whether a key exists without affecting its eviction order.
+//   - Stats: Returns the usage counters.
+//   - Close: Releases background resources.
+type Cache interface {
+	Get(key string) (value interface{}, ok bool)
+	Set(key string, value interface{})
+	Remove(key string)
+	Len() int
+	Clear()
+	Contains(key string) bool
+	Stats() Stats
+	Close()
+}
+
+// StatsTree represents usage counters broken down hierarchically, e.g. per stripe and per namespace.
+//
+// Fields:
+//   - Name: The name of the node: empty for the root, the stripe index, or the namespace prefix.
+//   - Stats: The counters of the node, which include those of its children.
+//   - Children: The breakdown of the node, if any.
+type StatsTree struct {
+	Name     string      `json:"name"`
+	Stats    Stats       `json:"stats"`
+	Children []StatsTree `json:"children,omitempty"`
+}
+
+// Stats represents a point-in-time copy of a cache's usage counters.
+//
+// Fields:
+//   - Hits: The number of lookups served from the cache.
+//   - Misses: The number of lookups that found no live entry.
+//   - Evictions: The number of entries removed to respect the capacity.
+//   - Expirations: The number of entries removed because they expired.
+//   - Panics: The number of user callbacks (eviction, expiration, event, loader, close, and index) that panicked and were recovered.
+//   - Len: The number of entries at the time of the call.
+//   - Latency: The operation latency histograms, or nil unless latency tracking is enabled.
+type Stats struct {
+	Hits        uint64   `json:"hits"`
+	Misses      uint64   `json:"misses"`
+	Evictions   uint64   `json:"evictions"`
+	Expirations uint64   `json:"expirations"`
+	Panics      uint64   `json:"panics"`
+	Len         int      `json:"len"`
+	Latency     *Latency `json:"latency,omitempty"`
+}
+
+// Latency represents a point-in-time copy of the latency histograms of a cache.
+//
+// Fields:
+//   - Get: The latency of Get calls.
+//   - Set: The latency of Set calls.
+//   - Load: The latency of Loader calls made by a Loading cache wrapping the LRU.
+type Latency struct {
+	Get  Histogram `json:"get"`
+	Set  Histogram `json:"set"`
+	Load Histogram `json:"load"`
+}
+
+// Histogram represents a latency distribution over fixed buckets.
+//
+// Fields:
+//   - Bounds: The inclusive upper bound of each bucket, in increasing order.
+//   - Counts: The number of observations per bucket; the last count, past the final bound, has no upper bound.
+//   - Count: The total number of observations.
+//   - Sum: The total of all observed durations.
+//
+// Durations are encoded in JSON as nanoseconds.
+type Histogram struct {
+	Bounds []time.Duration `json:"bounds_ns"`
+	Counts []uint64        `json:"counts"`
+	Count  uint64          `json:"count"`
+	Sum    time.Duration   `json:"sum_ns"`
+}
+
+// latencies represents the live latency histograms of a cache.
+//
+// Fields:
+//   - get: The histogram of Get calls.
+//   - set: The histogram of Set calls.
+//   - load: The histogram of Loader calls.
+type latencies struct {
+	get  histogram
+	set  histogram
+	load histogram
+}
+
+// histogram represents a live latency distribution updated atomically.
+//
+// Fields:
+//   - buckets: The observation count per bucket of latencyBounds, plus one overflow bucket.
+//   - count: The total number of observations.
+//   - sum: The total of all observed durations, in nanoseconds.
+type histogram struct {
+	buckets [len(latencyBounds) + 1]atomic.Uint64
+	count   atomic.Uint64
+	sum     atomic.Int64
+}
+
+// counters represents the live usage counters of a cache, updated atomically so they can be
+// incremented under a read lock.
+// Fields:
+//   - hits: The number of lookups served from the cache.
+//   - misses: The number of lookups that found no live entry.
+//   - evictions: The number of entries removed to respect the capacity.
+//   - expirations: The number of entries removed because they expired.
+//   - allocated: The number of entries freshly allocated (LRU only).
+//   - reused: The number of entries taken from the entry pool (LRU only).
+//   - window: Optional per-interval counters covering only the recent past.
+type counters struct {
+	hits        atomic.Uint64
+	misses      atomic.Uint64
+	evictions   atomic.Uint64
+	expirations atomic.Uint64
+	allocated   atomic.Uint64
+	reused      atomic.Uint64
+	panics      atomic.Uint64
+	window      atomic.Pointer[statsWindow]
+}
+
+// statsWindow represents a ring of counters, one per interval, forming a sliding window.
+//
+// Fields:
+//   - width: The length of one interval.
+//   - buckets: The ring of interval counters; an interval's bucket is its epoch modulo the ring size.
+type statsWindow struct {
+	width   time.Duration
+	buckets []windowBucket
+}
+
+// windowBucket represents the counters of one interval of a sliding window.
+//
+// Fields:
+//   - epoch: The interval the counters belong to, plus one so that zero marks an unused bucket.
+//   - hits: The number of lookups served from the cache during the interval.
+//   - misses: The number of lookups that found no live entry during the interval.
+//   - evictions: The number of capacity evictions during the interval.
+//   - expirations: The number of expirations during the interval.
+type windowBucket struct {
+	epoch       atomic.Int64
+	hits        atomic.Uint64
+	misses      atomic.Uint64
+	evictions   atomic.Uint64
+	expirations atomic.Uint64
+}
+
+// PoolStats represents how an LRU cache obtained its entries.
+//
+// Fields:
+//   - Allocated: The number of entries freshly allocated.
+//   - Reused: The number of entries recycled from evicted ones.
+type PoolStats struct {
+	Allocated uint64
+	Reused    uint64
+}
+
+// EvictionPolicy selects which entry capacity-based eviction removes.
+type EvictionPolicy int
+
+// StateOrder selects the order of the states returned by GetStates.
+type StateOrder int
+
+// State represents a point-in-time copy of the metadata of a cache entry, as returned by GetStates,
+// GetState, GetStateByKey, TopN, and similar inspection methods.
 // Fields:
 //   - key: The key of the cache entry.
 //   - value: The value associated with the key.
 //   - accessTime: The last time the entry was accessed.
 //   - expiration: The expiration time of the entry.
-type state struct {
-	key        string
-	value      interface{}
-	accessTime time.Time
-	expiration time.Time
+//   - accessCount: The number of times the entry has been read.
+//   - meta: The metadata attached to the entry.
+//   - version: The version of the entry's value.
+type State struct {
+	key         string
+	value       interface{}
+	accessTime  time.Time
+	expiration  time.Time
+	accessCount uint64
+	meta        map[string]string
+	version     uint64
+}
+
+// prefixNode represents a node of the key trie used for prefix-scoped operations.
+// Fields:
+//   - children: The child nodes indexed by the next key byte.
+//   - terminal: Whether a key ends at this node.
+//   - count: The number of keys ending at or below this node.
+type prefixNode struct {
+	children map[byte]*prefixNode
+	terminal bool
+	count    int
+}
+
+// quota represents the entry limit of a namespace and the recency order of its entries, so that
+// enforcing it never walks entries of other namespaces.
+// Fields:
+//   - maxEntries: The maximum number of entries under the prefix.
+//   - order: The namespace's elements of the cache list, most recently used first.
+//   - index: The node of order holding each element.
+type quota struct {
+	maxEntries int
+	order      *list.List
+	index      map[*list.Element]*list.Element
 }
 
 // entries represents a cache entry with associated metadata.
@@ -51,8 +400,837 @@ type state struct {
 //   - key: The key of the entry.
 //   - value: The value associated with the key.
 //   - expiration: The expiration time of the entry.
+//   - accessTime: The last time the entry was written or read.
+//   - accessCount: The number of times the entry has been read.
+//   - tags: The tags associated with the entry.
+//   - pinned: Whether the entry is exempt from capacity-based eviction.
+//   - meta: Arbitrary caller-supplied metadata, such as the source, version, or etag of the value.
+//   - version: The version of the value, taken from the cache-wide version counter on every write.
+//   - onExpire: An optional callback invoked when this entry expires, in addition to the cache-wide one.
+//   - onEvict: An optional callback invoked when this entry's value leaves the cache, in addition to the cache-wide one.
+//   - delta: The time a Loader took to compute the value, used for probabilistic early expiration.
+//   - indexed: The secondary index values extracted from the value.
+//   - hash: The content hash of the value, when hashed is true.
+//   - hashed: Whether the value is recorded in the value-hash index.
+//   - persistent: Whether the entry is exempt from the cache-wide expiry.
+//   - deadline: Whether expiration is an absolute deadline set by SetWithDeadline, kept by Update and Touch.
 type entries struct {
-	key        string
+	key         string
+	value       interface{}
+	expiration  time.Time
+	accessTime  time.Time
+	accessCount uint64
+	tags        []string
+	pinned      bool
+	meta        map[string]string
+	version     uint64
+	onExpire    OnCallback
+	onEvict     OnCallback
+	delta       time.Duration
+	indexed     []string
+	hash        uint64
+	hashed      bool
+	persistent  bool
+	deadline    bool
+}
+
+// OnErrorCallback is a callback function type that gets called when a backing store operation fails
+// and the calling method has no error return value.
+// Parameters:
+//   - key: The key involved in the failing operation.
+//   - err: The error returned by the backing store.
+type OnErrorCallback func(key string, err error)
+
+// Store represents a pluggable backing store used as a second cache tier.
+// Implementations must be safe for concurrent use.
+//
+// Methods:
+//   - Get: Retrieves the value for a key. ok is false when the key does not exist.
+//   - Set: Stores a value for a key with the given time-to-live. Zero ttl means no expiration.
+//   - Delete: Removes a key. Deleting a missing key is not an error.
+type Store interface {
+	Get(ctx context.Context, key string) (value interface{}, ok bool, err error)
+	Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error
+	Delete(ctx context.Context, key string) error
+}
+
+// KeyTransform is a function type that canonicalizes keys, e.g. by lowercasing or trimming them.
+// It must be idempotent: transforming an already transformed key must return it unchanged.
+// Parameters:
+//   - key: The key as passed by the caller.
+//
+// Returns:
+//   - The canonical key.
+type KeyTransform func(key string) string
+
+// Indexer is a function type that extracts the secondary index values of a cached value,
+// e.g. the user ID owning a session.
+// Parameters:
+//   - value: The value being stored.
+//
+// Returns:
+//   - The index values under which the entry can be found with GetByIndex; nil if none.
+type Indexer func(value interface{}) []string
+
+// Hasher is a function type that computes a 64-bit hash of a key, used to spread keys across stripes.
+// Parameters:
+//   - key: The key to hash.
+//
+// Returns:
+//   - The hash of the key.
+type Hasher func(key string) uint64
+
+// Copier is a function type that returns an independent copy of a value.
+// Parameters:
+//   - value: The value to copy.
+//
+// Returns:
+//   - A copy sharing no mutable state with value.
+type Copier func(value interface{}) interface{}
+
+// Sizer is a function type that estimates the size of a value in bytes.
+// Parameters:
+//   - value: The value to measure.
+//
+// Returns:
+//   - The estimated size in bytes.
+type Sizer func(value interface{}) int
+
+// ValueSizeError is returned when a value exceeds the maximum size accepted by the cache.
+// It matches ErrTooLarge with errors.Is.
+//
+// Fields:
+//   - Key: The key the value was written to.
+//   - Size: The size of the rejected value, in bytes.
+//   - Max: The maximum accepted size, in bytes.
+type ValueSizeError struct {
+	Key  string
+	Size int
+	Max  int
+}
+
+// Tx is a set of writes staged against an LRU cache by Apply and committed atomically.
+//
+// Methods:
+//   - Get: Returns the value of a key as the transaction sees it: its staged value, or the cache's.
+//   - Set: Stages a key-value pair.
+//   - Remove: Stages the removal of a key.
+type Tx interface {
+	Get(key string) (value interface{}, ok bool)
+	Set(key string, value interface{})
+	Remove(key string)
+}
+
+// tx represents the writes staged by an Apply call.
+//
+// Fields:
+//   - cache: The cache the writes are committed to.
+//   - ops: The staged writes, one per key, in the order each key was first staged.
+//   - staged: A map from key to its position in ops.
+type tx struct {
+	cache  *LRU
+	ops    []txOp
+	staged map[string]int
+}
+
+// txOp represents a write staged in a transaction.
+//
+// Fields:
+//   - key: The key written.
+//   - value: The value stored, unless the key is removed.
+//   - remove: Whether the key is removed rather than set.
+type txOp struct {
+	key    string
+	value  interface{}
+	remove bool
+}
+
+// Releaser is implemented by values owning a resource that must be released once they leave the cache.
+// With WithAutoClose, values implementing Releaser or io.Closer are released automatically.
+//
+// Methods:
+//   - Release: Frees the resource. It is called once, from its own goroutine.
+type Releaser interface {
+	Release()
+}
+
+// valueCloser represents the automatic release of values leaving a cache.
+//
+// Fields:
+//   - onError: An optional callback receiving the errors returned by Close.
+type valueCloser struct {
+	onError OnErrorCallback
+}
+
+// PanicError describes a panic recovered from a user callback.
+// It matches ErrPanic with errors.Is.
+//
+// Fields:
+//   - Hook: The callback that panicked: "evict", "expire", "event", "load", or "close".
+//   - Key: The key the callback was invoked for.
+//   - Value: The value passed to panic.
+//   - Stack: The stack trace of the panicking goroutine.
+type PanicError struct {
+	Hook  string
+	Key   string
+	Value interface{}
+	Stack []byte
+}
+
+// Tiered represents a two-tier cache composed of an in-memory LRU (L1) and a backing Store (L2).
+// Reads check L1 first and fall back to L2, promoting L2 hits back into L1.
+//
+// Fields:
+//   - l1: The in-memory LRU cache.
+//   - l2: The backing store consulted on L1 misses.
+//   - ttl: The time-to-live used for L2 writes. Zero means the L1 expiration is used.
+//   - onError: An optional callback invoked when an L2 operation fails in a method without an error return.
+type Tiered struct {
+	l1      *LRU
+	l2      Store
+	ttl     time.Duration
+	onError OnErrorCallback
+}
+
+// Codec represents a value serializer used wherever cache values leave process memory,
+// such as network-backed stores.
+//
+// Methods:
+//   - Marshal: Encodes a value into bytes.
+//   - Unmarshal: Decodes bytes into the value pointed to by v.
+type Codec interface {
+	Marshal(value interface{}) ([]byte, error)
+	Unmarshal(data []byte, v interface{}) error
+}
+
+// JSONCodec is a Codec backed by encoding/json.
+// Decoding into an interface{} yields the generic JSON types (map[string]interface{}, float64, ...).
+type JSONCodec struct{}
+
+// GobCodec is a Codec backed by encoding/gob.
+// Values are encoded as interface{} so their concrete types must be registered with gob.Register,
+// and they must be decoded into a *interface{}.
+type GobCodec struct{}
+
+// Compressor represents a byte-level compression algorithm applied to large cache values.
+// Implementations must be safe for concurrent use.
+//
+// Methods:
+//   - Compress: Compresses data.
+//   - Decompress: Restores data produced by Compress.
+type Compressor interface {
+	Compress(data []byte) ([]byte, error)
+	Decompress(data []byte) ([]byte, error)
+}
+
+// GzipCompressor is a Compressor backed by compress/gzip.
+// Fields:
+//   - Level: The gzip compression level. Zero means gzip.DefaultCompression.
+type GzipCompressor struct {
+	Level int
+}
+
+// SnappyCompressor is a Compressor backed by the snappy block format,
+// trading compression ratio for speed.
+type SnappyCompressor struct{}
+
+// compressed represents a value stored in compressed form.
+// Fields:
+//   - data: The compressed bytes.
+//   - text: Whether the original value was a string rather than a []byte.
+//   - compressor: The compressor that produced data.
+type compressed struct {
+	data       []byte
+	text       bool
+	compressor Compressor
+}
+
+// encoded represents a value stored in serialized form.
+// Fields:
+//   - data: The encoding of the value, as a []byte or compressed when eligible.
+//   - size: The length of the encoding before compression, used for size accounting.
+//   - codec: The codec that produced the encoding.
+type encoded struct {
+	data  interface{}
+	size  int
+	codec Codec
+}
+
+// EncryptedCodec is a Codec that seals the output of another codec with an AEAD cipher such as AES-GCM,
+// so values written to disk or network tiers are never stored in plaintext.
+// Fields:
+//   - codec: The codec serializing values before encryption.
+//   - aead: The cipher sealing the serialized bytes.
+type EncryptedCodec struct {
+	codec Codec
+	aead  cipher.AEAD
+}
+
+// fileEntry represents the on-disk form of an Entry, with the value pre-encoded by the cache codec.
+// Fields:
+//   - Key: The key of the entry.
+//   - Value: The value encoded with the cache codec.
+//   - Expiration: The absolute expiration time.
+//   - AccessTime: The last time the entry was written or read.
+//   - AccessCount: The number of times the entry has been read.
+//   - Tags: The tags associated with the entry.
+//   - Pinned: Whether the entry is exempt from capacity-based eviction.
+//   - Meta: The metadata attached to the entry.
+//   - Persistent: Whether the entry is exempt from the cache-wide expiry.
+//   - Deadline: Whether Expiration is an absolute deadline kept by Update and Touch.
+type fileEntry struct {
+	Key         string
+	Value       []byte
+	Expiration  time.Time
+	AccessTime  time.Time
+	AccessCount uint64
+	Tags        []string
+	Pinned      bool
+	Meta        map[string]string
+	Persistent  bool
+	Deadline    bool
+}
+
+// WriteThrough represents a decorator over an LRU cache that synchronously mirrors
+// every write and removal to a backing Store, keeping both consistent.
+//
+// Fields:
+//   - cache: The decorated in-memory LRU cache.
+//   - store: The durable backing store receiving every write.
+//   - ttl: The time-to-live used for store writes. Zero means the cache expiration is used.
+//   - onError: An optional callback invoked when a store operation fails in a method without an error return.
+type WriteThrough struct {
+	cache   *LRU
+	store   Store
+	ttl     time.Duration
+	onError OnErrorCallback
+}
+
+// WriteBehind represents a decorator over an LRU cache that records writes and removals as dirty
+// and flushes them asynchronously to a backing Store in batches.
+//
+// Fields:
+//   - cache: The decorated in-memory LRU cache.
+//   - store: The durable backing store receiving flushed writes.
+//   - ttl: The time-to-live used for store writes. Zero means the cache expiration is used.
+//   - batchSize: The number of dirty keys that triggers an early flush.
+//   - attempts: The number of times a store operation is tried before giving up.
+//   - backoff: The initial delay between retries, doubled after each failed attempt.
+//   - onError: An optional callback invoked when a store operation fails after all retries.
+//   - mutex: A lock protecting the dirty set and configuration.
+//   - flushMutex: A lock serializing flushes.
+//   - dirty: Pending operations keyed by cache key; later writes to a key supersede earlier ones.
+//   - trigger: A channel used to request an early flush when the batch size is reached.
+//   - stop: A channel closed to stop the background flusher.
+//   - done: A channel closed once the background flusher has exited.
+//   - closeOnce: Ensures Close only runs once.
+type WriteBehind struct {
+	cache      *LRU
+	store      Store
+	ttl        time.Duration
+	batchSize  int
+	attempts   int
+	backoff    time.Duration
+	onError    OnErrorCallback
+	mutex      sync.Mutex
+	flushMutex sync.Mutex
+	dirty      map[string]pending
+	trigger    chan struct{}
+	stop       chan struct{}
+	done       chan struct{}
+	closeOnce  sync.Once
+}
+
+// pending represents a dirty operation waiting to be flushed to the backing store.
+// Fields:
+//   - value: The value to write.
+//   - deleted: Whether the key should be deleted rather than written.
+type pending struct {
+	value   interface{}
+	deleted bool
+}
+
+// Invalidation represents a message telling peer instances to drop their local copies of keys.
+//
+// Fields:
+//   - Origin: The identifier of the publishing instance, used to ignore its own messages.
+//   - Keys: The keys to invalidate.
+type Invalidation struct {
+	Origin string   `json:"origin"`
+	Keys   []string `json:"keys"`
+}
+
+// Broadcaster represents a pluggable transport that delivers invalidation messages between instances.
+// Implementations must be safe for concurrent use.
+//
+// Methods:
+//   - Publish: Sends an invalidation message to all subscribed instances, including the sender.
+//   - Subscribe: Registers a handler invoked for every received message. It must not block;
+//     delivery happens on a goroutine owned by the implementation.
+//   - Close: Releases the transport's resources and stops delivery.
+type Broadcaster interface {
+	Publish(ctx context.Context, msg Invalidation) error
+	Subscribe(ctx context.Context, handler func(msg Invalidation)) error
+	Close() error
+}
+
+// Invalidator represents a decorator over an LRU cache that keeps multiple instances coherent
+// by broadcasting every local update or removal so peers drop their stale copies.
+//
+// Fields:
+//   - cache: The decorated in-memory LRU cache.
+//   - broadcaster: The transport used to exchange invalidation messages.
+//   - origin: A unique identifier for this instance.
+//   - onError: An optional callback invoked when publishing fails.
+type Invalidator struct {
+	cache       *LRU
+	broadcaster Broadcaster
+	origin      string
+	onError     OnErrorCallback
+}
+
+// View represents a namespaced handle onto a shared LRU cache.
+// Every key passed to a View is transparently prefixed, so several tenants can share
+// one cache and one capacity budget without key collisions.
+//
+// Fields:
+//   - cache: The shared underlying LRU cache.
+//   - prefix: The namespace prefix prepended to every key.
+type View struct {
+	cache  *LRU
+	prefix string
+}
+
+// Entry represents a self-contained copy of a cache entry, used to snapshot and restore a cache.
+//
+// Fields:
+//   - Key: The key of the entry.
+//   - Value: The value associated with the key.
+//   - Expiration: The absolute expiration time. The zero time means the entry never expires.
+//   - AccessTime: The last time the entry was written or read.
+//   - AccessCount: The number of times the entry has been read.
+//   - Tags: The tags associated with the entry.
+//   - Pinned: Whether the entry is exempt from capacity-based eviction.
+//   - Meta: The metadata attached to the entry.
+//   - Persistent: Whether the entry is exempt from the cache-wide expiry (see SetPersistent).
+//   - Deadline: Whether Expiration is an absolute deadline kept by Update and Touch (see SetWithDeadline).
+type Entry struct {
+	Key         string            `json:"key"`
+	Value       interface{}       `json:"value"`
+	Expiration  time.Time         `json:"expiration"`
+	AccessTime  time.Time         `json:"access_time"`
+	AccessCount uint64            `json:"access_count"`
+	Tags        []string          `json:"tags,omitempty"`
+	Pinned      bool              `json:"pinned,omitempty"`
+	Meta        map[string]string `json:"meta,omitempty"`
+	Persistent  bool              `json:"persistent,omitempty"`
+	Deadline    bool              `json:"deadline,omitempty"`
+}
+
+// Loader represents a function that fetches the value of a key missing from a loading cache.
+// Parameters:
+//   - ctx: The context of the calling operation.
+//   - key: The key to load.
+//
+// Returns:
+//   - The loaded value, or an error if it could not be fetched. Errors are never cached.
+type Loader func(ctx context.Context, key string) (interface{}, error)
+
+// BatchLoader represents a function that fetches the values of many keys missing from a loading cache at once.
+// Parameters:
+//   - ctx: The context of the batch.
+//   - keys: The keys to load.
+//
+// Returns:
+//   - The loaded values keyed by key; keys absent from the map are reported as not found.
+//   - An error if the batch could not be fetched, failing every key. Errors are never cached.
+type BatchLoader func(ctx context.Context, keys []string) (map[string]interface{}, error)
+
+// Loading represents a read-through decorator over an LRU cache that fills misses through a Loader.
+// Concurrent misses on the same key share a single Loader call.
+//
+// Fields:
+//   - cache: The decorated in-memory LRU cache.
+//   - loader: The function invoked to fetch missing keys.
+//   - concurrency: The maximum number of Loader calls running at once during Preload.
+//   - mutex: A lock protecting the in-flight calls.
+//   - calls: The in-flight loads keyed by cache key.
+//   - self: The name of this instance on the owners ring.
+//   - owners: An optional ring assigning each key to the instance responsible for loading it.
+//   - fetches: The in-flight fetches from owning peers keyed by cache key.
+//   - beta: The XFetch scaling factor for probabilistic early expiration. Zero disables it.
+//   - maxStale: How long past its expiry a value may be served when loading fails. Zero disables it.
+//   - stale: The expired values kept for stale-if-error, keyed by cache key.
+//   - staleSweep: The time after which the next expired value recorded prunes the stale values.
+//   - attempts: The total number of Loader tries per load.
+//   - backoff: The initial delay between Loader tries, doubled after each failure.
+//   - jitter: The fraction of each delay between tries that is randomized.
+//   - retryable: An optional function deciding which Loader errors are retried.
+//   - absent: An optional bloom filter of keys the Loader reported as absent.
+//   - absentReset: How often the absent filter is emptied. Zero never empties it.
+//   - absentSince: The time the absent filter was last emptied.
+type Loading struct {
+	cache       *LRU
+	loader      Loader
+	concurrency int
+	mutex       sync.Mutex
+	calls       map[string]*call
+	self        string
+	owners      *StoreRing
+	fetches     map[string]*call
+	beta        float64
+	maxStale    time.Duration
+	stale       map[string]staleValue
+	staleSweep  time.Time
+	attempts    int
+	backoff     time.Duration
+	jitter      float64
+	retryable   func(err error) bool
+	absent      *bloomFilter
+	absentReset time.Duration
+	absentSince time.Time
+}
+
+// bloomFilter represents a fixed-size set of keys answering membership with false positives but no
+// false negatives.
+// Fields:
+//   - bits: The bit array.
+//   - m: The number of bits.
+//   - k: The number of bits set per key.
+type bloomFilter struct {
+	bits []uint64
+	m    uint64
+	k    uint64
+}
+
+// staleValue represents an expired value kept to be served if reloading it fails.
+// Fields:
+//   - value: The expired value.
+//   - expiration: The time the value expired.
+type staleValue struct {
 	value      interface{}
 	expiration time.Time
 }
+
+// batcher collects the misses of a batch-loading cache into batches for a BatchLoader.
+// Fields:
+//   - cache: The cache whose panic callback and stats report loader panics.
+//   - loader: The function fetching a batch of keys.
+//   - window: How long a batch collects keys before it is flushed.
+//   - maxBatch: The number of keys that flushes a batch early. Zero or less means unbounded.
+//   - mutex: A lock protecting the pending batch.
+//   - pending: The batch currently collecting keys, if any.
+type batcher struct {
+	cache    *LRU
+	loader   BatchLoader
+	window   time.Duration
+	maxBatch int
+	mutex    sync.Mutex
+	pending  *batch
+}
+
+// batch represents one call of a BatchLoader shared by every key it collected.
+// Fields:
+//   - ctx: The context the loader is called with.
+//   - keys: The keys collected.
+//   - done: A channel closed once the loader has returned.
+//   - values: The loaded values keyed by key.
+//   - err: The error returned by the loader.
+type batch struct {
+	ctx    context.Context
+	keys   []string
+	done   chan struct{}
+	values map[string]interface{}
+	err    error
+}
+
+// call represents an in-flight load shared by every caller waiting on the same key.
+// Fields:
+//   - done: A channel closed once the load has completed.
+//   - value: The loaded value.
+//   - err: The error returned by the Loader.
+type call struct {
+	done  chan struct{}
+	value interface{}
+	err   error
+}
+
+// Clock represents a CLOCK (second-chance) cache, an approximation of LRU that keeps entries in a
+// circular buffer with a reference bit instead of reordering a list on every read.
+// Reads only take the read lock, which makes it faster than LRU for read-dominated workloads.
+//
+// Fields:
+//   - capacity: The maximum number of items the cache can hold. Zero or less means unbounded.
+//   - slots: The circular buffer of entries.
+//   - index: A map from key to slot position.
+//   - free: The positions of slots emptied by removals, reused before the buffer grows.
+//   - hand: The position where the next eviction scan starts.
+//   - mutex: A read-write lock to ensure thread-safe operations.
+//   - onEvict: An optional callback function invoked when an item is evicted.
+//   - onPanic: An optional callback notified when the eviction callback panics.
+//   - stats: The hit, miss, and eviction counters.
+type Clock struct {
+	capacity int
+	slots    []*clockSlot
+	index    map[string]int
+	free     []int
+	hand     int
+	mutex    sync.RWMutex
+	onEvict  OnCallback
+	onPanic  OnErrorCallback
+	stats    counters
+}
+
+// clockSlot represents one position of the CLOCK buffer.
+// Fields:
+//   - key: The key of the entry.
+//   - value: The value associated with the key.
+//   - used: Whether the slot holds an entry.
+//   - referenced: The reference bit, set on access and cleared as the hand passes.
+type clockSlot struct {
+	key        string
+	value      interface{}
+	used       bool
+	referenced atomic.Bool
+}
+
+// LRUK represents an LRU-K cache, which evicts the entry whose K-th most recent access is the oldest.
+// Entries referenced fewer than K times are evicted first, so a burst of one-off accesses cannot
+// flush frequently used entries the way it does in a plain LRU.
+//
+// Fields:
+//   - capacity: The maximum number of items the cache can hold. Zero or less means unbounded.
+//   - k: The number of most recent accesses tracked per entry.
+//   - items: A map for quick access to cache entries by key.
+//   - queue: A min-heap of entries ordered by eviction priority.
+//   - history: The access history of recently evicted keys, restored if they are re-admitted.
+//   - retained: The evicted keys in eviction order, bounding the size of history.
+//   - tick: A logical clock incremented on every access.
+//   - mutex: A lock to ensure thread-safe operations; reads update the access history.
+//   - onEvict: An optional callback function invoked when an item is evicted.
+//   - onPanic: An optional callback notified when the eviction callback panics.
+//   - stats: The hit, miss, and eviction counters.
+type LRUK struct {
+	capacity int
+	k        int
+	items    map[string]*lrukEntry
+	queue    lrukQueue
+	history  map[string][]uint64
+	retained *list.List
+	tick     uint64
+	mutex    sync.Mutex
+	onEvict  OnCallback
+	onPanic  OnErrorCallback
+	stats    counters
+}
+
+// lrukEntry represents an LRU-K cache entry.
+// Fields:
+//   - key: The key of the entry.
+//   - value: The value associated with the key.
+//   - refs: The logical times of the most recent accesses, most recent first, at most K of them.
+//   - index: The position of the entry in the heap.
+type lrukEntry struct {
+	key   string
+	value interface{}
+	refs  []uint64
+	index int
+}
+
+// lrukQueue represents a heap of LRU-K entries implementing heap.Interface.
+// Fields:
+//   - entries: The heap-ordered entries.
+//   - k: The number of most recent accesses tracked per entry.
+type lrukQueue struct {
+	entries []*lrukEntry
+	k       int
+}
+
+// Noop represents a cache that never stores anything, used to disable caching through configuration
+// without changing call sites. Every lookup is a miss.
+//
+// Fields:
+//   - stats: The miss counter.
+type Noop struct {
+	stats counters
+}
+
+// PassThrough represents a loading cache that never stores anything: every lookup calls the Loader.
+// It keeps read-through call sites working when caching is disabled.
+//
+// Fields:
+//   - loader: The function invoked on every lookup.
+//   - stats: The hit and miss counters, where a hit is a successful load.
+type PassThrough struct {
+	loader Loader
+	stats  counters
+}
+
+// ReadOnlyCache represents the read side of a cache, handed to code that must not modify or clear it.
+//
+// Methods:
+//   - Get: Retrieves the value of a key and whether it exists.
+//   - Contains: Reports whether a key exists.
+//   - Len: Returns the number of entries.
+//   - Stats: Returns the usage counters.
+type ReadOnlyCache interface {
+	Get(key string) (value interface{}, ok bool)
+	Contains(key string) bool
+	Len() int
+	Stats() Stats
+}
+
+// readOnly represents a ReadOnlyCache view over a cache. Wrapping the cache, rather than returning it
+// as the interface, keeps holders from type-asserting their way back to the mutating methods.
+// Fields:
+//   - cache: The underlying cache.
+type readOnly struct {
+	cache Cache
+}
+
+// Striped represents a cache whose keys are spread by hash over several independently locked LRU stripes.
+// Operations on different stripes never contend, at the cost of an approximate global recency order:
+// each stripe evicts its own least recently used entry.
+//
+// Fields:
+//   - stripes: The LRU caches holding the keys.
+//   - hasher: The function mapping keys to stripes: base, mixed with seed when it is non-zero.
+//   - base: The hasher set by WithHasher, which Reseed mixes its seed into.
+//   - mask: The stripe count minus one, used to pick a stripe from a hash.
+//   - seed: The seed set by Reseed, or zero.
+//   - route: Guards hasher, seed, and keyTransform; keyed operations hold it for reading so Reseed can move keys under them.
+//   - keyTransform: An optional function canonicalizing keys before they are hashed.
+type Striped struct {
+	stripes      []*LRU
+	hasher       Hasher
+	base         Hasher
+	mask         uint64
+	seed         uint64
+	route        sync.RWMutex
+	keyTransform KeyTransform
+}
+
+// SkewReport represents how evenly a Striped cache spreads its keys and accesses across stripes.
+//
+// Fields:
+//   - Keys: The number of keys held by each stripe.
+//   - Accesses: The reads of the keys held by each stripe, plus one per key.
+//   - KeySkew: The largest key count divided by the average key count; 1 is a perfect spread.
+//   - AccessSkew: The largest access count divided by the average access count.
+//   - Skewed: Whether the access skew exceeds the threshold the report was made with.
+//   - Seed: The seed currently used to spread keys.
+//   - Suggested: A seed that would lower the access skew, or zero if none was found or needed.
+//   - ProjectedSkew: The access skew the suggested seed would give.
+type SkewReport struct {
+	Keys          []int    `json:"keys"`
+	Accesses      []uint64 `json:"accesses"`
+	KeySkew       float64  `json:"key_skew"`
+	AccessSkew    float64  `json:"access_skew"`
+	Skewed        bool     `json:"skewed"`
+	Seed          uint64   `json:"seed"`
+	Suggested     uint64   `json:"suggested,omitempty"`
+	ProjectedSkew float64  `json:"projected_skew,omitempty"`
+}
+
+// StoreRing represents a Store spreading keys across several named Stores with consistent hashing,
+// so adding or removing a node only remaps the keys that node owns.
+//
+// Fields:
+//   - mutex: A read-write lock guarding the ring.
+//   - replicas: The number of virtual points per node.
+//   - hasher: The hash function placing keys and points on the ring.
+//   - points: The sorted hash points on the ring.
+//   - owners: The node name owning each point.
+//   - nodes: The Stores by node name.
+type StoreRing struct {
+	mutex    sync.RWMutex
+	replicas int
+	hasher   Hasher
+	points   []uint64
+	owners   map[uint64]string
+	nodes    map[string]Store
+}
+
+// EventOp identifies the kind of change reported by an Event.
+type EventOp int
+
+// Event represents a change to the contents of a cache, as reported to an EventListener.
+//
+// Fields:
+//   - Op: The kind of change.
+//   - Key: The key concerned; empty for EventClear.
+//   - Value: The value stored for EventSet, or the value removed otherwise; nil for EventClear.
+//   - Time: When the change happened.
+type Event struct {
+	Op    EventOp     `json:"op"`
+	Key   string      `json:"key,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+	Time  time.Time   `json:"time"`
+}
+
+// EventListener is a function type notified of every change to a cache.
+// It is called with the cache lock held, so it must be fast and must not call back into the cache.
+// Parameters:
+//   - event: The change.
+type EventListener func(event Event)
+
+// Exporter represents a change-data-capture stream writing cache events as line-delimited JSON.
+//
+// Fields:
+//   - writer: The destination; each record is written with a single Write call.
+//   - records: The encoded records waiting to be written.
+//   - mutex: A lock guarding closed against concurrent sends; it is never held while blocking.
+//   - closed: Whether Close has been called.
+//   - done: Closed once every buffered record has been written.
+//   - dropped: The number of events discarded because the buffer was full.
+//   - onError: An optional callback invoked when an event cannot be encoded or written.
+type Exporter struct {
+	writer  io.Writer
+	records chan exportRecord
+	mutex   sync.RWMutex
+	closed  bool
+	done    chan struct{}
+	dropped atomic.Uint64
+	onError atomic.Pointer[OnErrorCallback]
+}
+
+// exportRecord represents one event encoded by Exporter.Handle.
+//
+// Fields:
+//   - key: The key of the event, passed to the error callback.
+//   - data: The JSON encoding of the event.
+//   - err: The encoding error, if any; the record is then reported instead of written.
+type exportRecord struct {
+	key  string
+	data []byte
+	err  error
+}
+
+// CapacityController represents a background loop adjusting the capacity of an LRU between bounds,
+// growing it while the hit ratio is low and shrinking it under memory pressure.
+//
+// Fields:
+//   - cache: The cache whose capacity is adjusted.
+//   - minCapacity: The smallest capacity the controller sets.
+//   - maxCapacity: The largest capacity the controller sets.
+//   - targetHitRatio: The hit ratio below which a full cache grows.
+//   - memoryLimit: The heap size the headroom is measured against, in bytes. Zero uses the runtime memory limit.
+//   - headroom: The fraction of the memory limit that must stay free; below it the cache shrinks.
+//   - mutex: A lock guarding the settings, the counters of the previous adjustment, and closing stop.
+//   - hits: The hit count at the previous adjustment.
+//   - misses: The miss count at the previous adjustment.
+//   - stop: Closed by Close to stop the loop.
+//   - done: Closed once the loop has exited.
+type CapacityController struct {
+	cache          *LRU
+	minCapacity    int
+	maxCapacity    int
+	targetHitRatio float64
+	memoryLimit    uint64
+	headroom       float64
+	mutex          sync.Mutex
+	hits           uint64
+	misses         uint64
+	stop           chan struct{}
+	done           chan struct{}
+}
@@ -0,0 +1,151 @@
+package cachify
+
+import (
+	"sort"
+
+	"github.com/cespare/xxhash/v2"
+)
+
+// WithValueHashIndex maintains an index from the content hash of each value to the keys storing it.
+//
+// Parameters:
+//   - enabled: Whether the index is maintained. Disabling it discards the index.
+//
+// Returns:
+//   - The LRU cache, for chaining.
+//
+// Details:
+//   - Every write hashes its value (see HashValue), so enabling the index costs an encoding per write
+//     for values that are not []byte or string.
+//   - Entries already cached are hashed immediately.
+func (c *LRU) WithValueHashIndex(enabled bool) *LRU {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.hashValues = enabled
+	c.hashes = nil
+	for element := c.list.Front(); element != nil; element = element.Next() {
+		entry := element.Value.(*entries)
+		entry.hashed = false
+		if enabled {
+			c.rehash(entry, c.view(entry.value))
+		}
+	}
+	return c
+}
+
+// HashValue returns the content hash of a value, as recorded by the value-hash index.
+//
+// Parameters:
+//   - value: The value to hash.
+//
+// Returns:
+//   - The xxHash64 of the value's bytes: []byte and string values are hashed as is, and other values
+//     are first encoded with the cache's codec, or JSONCodec if none is set.
+//   - An error if the value cannot be encoded.
+//
+// Details:
+//   - Equal values only hash equally if the codec encodes them identically; JSONCodec sorts map keys,
+//     while GobCodec does not and requires concrete types to be registered.
+func (c *LRU) HashValue(value interface{}) (uint64, error) {
+	c.mutex.RLock()
+	codec := c.codec
+	c.mutex.RUnlock()
+	return hashValue(codec, value)
+}
+
+// ValueHash returns the content hash of the value stored under a key.
+//
+// Returns:
+//   - The hash, and true if the key exists and its value was hashed by the value-hash index.
+func (c *LRU) ValueHash(key string) (uint64, bool) {
+	key = c.normalizeKey(key)
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	if element, exists := c.cache[key]; exists {
+		entry := element.Value.(*entries)
+		return entry.hash, entry.hashed
+	}
+	return 0, false
+}
+
+// FindByValueHash returns the keys whose values have the given content hash.
+//
+// Parameters:
+//   - hash: A hash returned by HashValue or ValueHash.
+//
+// Returns:
+//   - The matching keys, sorted; nil if none match or the value-hash index is disabled.
+//
+// Details:
+//   - Keys sharing a hash store identical values, barring a hash collision, which makes the index
+//     suitable for deduplication analysis. Expired entries not yet removed are included.
+func (c *LRU) FindByValueHash(hash uint64) []string {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	matches := c.hashes[hash]
+	if len(matches) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(matches))
+	for key := range matches {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// hashValue hashes the bytes of a value, encoding it with a codec unless it is []byte or string.
+func hashValue(codec Codec, value interface{}) (uint64, error) {
+	switch v := value.(type) {
+	case []byte:
+		return xxhash.Sum64(v), nil
+	case string:
+		return xxhash.Sum64String(v), nil
+	}
+	if codec == nil {
+		codec = JSONCodec{}
+	}
+	data, err := codec.Marshal(value)
+	if err != nil {
+		return 0, err
+	}
+	return xxhash.Sum64(data), nil
+}
+
+// rehash records the content hash of an entry's new value in the value-hash index.
+//
+// Details:
+//   - Must be called with the write lock held, after unhash. Values that cannot be encoded are not indexed.
+func (c *LRU) rehash(entry *entries, value interface{}) {
+	hash, err := hashValue(c.codec, value)
+	if err != nil {
+		return
+	}
+	entry.hash, entry.hashed = hash, true
+	if c.hashes == nil {
+		c.hashes = make(map[uint64]map[string]struct{})
+	}
+	keys, exists := c.hashes[hash]
+	if !exists {
+		keys = make(map[string]struct{})
+		c.hashes[hash] = keys
+	}
+	keys[entry.key] = struct{}{}
+}
+
+// unhash removes an entry's key from the value-hash index.
+//
+// Details:
+//   - Must be called with the write lock held.
+func (c *LRU) unhash(entry *entries) {
+	if !entry.hashed {
+		return
+	}
+	if keys, exists := c.hashes[entry.hash]; exists {
+		delete(keys, entry.key)
+		if len(keys) == 0 {
+			delete(c.hashes, entry.hash)
+		}
+	}
+	entry.hash, entry.hashed = 0, false
+}
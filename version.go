@@ -0,0 +1,95 @@
+package cachify
+
+import (
+	"time"
+)
+
+// GetWithVersion retrieves the value associated with a given key along with its version.
+//
+// Parameters:
+//   - key: The key whose value is to be retrieved.
+//
+// Returns:
+//   - The value associated with the key, or nil if the key is not found.
+//   - The version of the value, to pass to SetIfVersion.
+//   - A boolean indicating whether the key exists.
+//
+// Details:
+//   - Behaves like Get regarding recency, expiration, and statistics.
+func (c *LRU) GetWithVersion(key string) (value interface{}, version uint64, ok bool) {
+	if l := c.latency.Load(); l != nil {
+		defer l.get.observe(time.Now())
+	}
+	key = c.normalizeKey(key)
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	element := c.cache[key]
+	if element == nil {
+		c.countNamespaces(key, (*counters).miss)
+	}
+	value, ok = c.access(element)
+	if !ok {
+		return nil, 0, false
+	}
+	return value, element.Value.(*entries).version, true
+}
+
+// Version returns the current version of a key without affecting its recency.
+//
+// Returns:
+//   - The version, or 0 if the key does not exist or has expired.
+func (c *LRU) Version(key string) uint64 {
+	key = c.normalizeKey(key)
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	if element, exists := c.cache[key]; exists {
+		entry := element.Value.(*entries)
+		if !expired(entry, time.Now()) {
+			return entry.version
+		}
+	}
+	return 0
+}
+
+// SetIfVersion stores a value only if the key is still at the expected version (optimistic concurrency).
+//
+// Parameters:
+//   - key: The key to be added or updated.
+//   - value: The value to be associated with the key.
+//   - version: The version read earlier with GetWithVersion or Version. Zero means the key must be absent.
+//
+// Returns:
+//   - true if the value was stored, false if another write happened in between.
+//
+// Details:
+//   - Versions come from a cache-wide counter and increase on every write, so a key that was
+//     removed and re-added never reuses an older version.
+//   - On success behaves like Set regarding recency, expiration, and capacity eviction.
+func (c *LRU) SetIfVersion(key string, value interface{}, version uint64) bool {
+	key = c.normalizeKey(key)
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	var current uint64
+	if entry, exists := c.liveEntry(key); exists {
+		current = entry.version
+	}
+	if current != version {
+		return false
+	}
+	if c.set(key, value) == nil {
+		return false
+	}
+	c.enforceQuotas(key)
+	c.enforceCapacity(c.cache[key])
+	return true
+}
+
+// nextVersion returns a new version, greater than every version handed out before.
+// It must be called with the write lock held.
+func (c *LRU) nextVersion() uint64 {
+	c.version++
+	return c.version
+}
@@ -0,0 +1,49 @@
+package cachify
+
+// WithWatermarks bounds the cache by a high watermark and evicts down to a low watermark in one batch.
+//
+// Parameters:
+//   - low: The number of entries kept after a batch eviction. Zero, or a value not below high,
+//     disables batching so that each insert evicts exactly what overflows.
+//   - high: The hard limit, which becomes the capacity of the cache (see SetCapacity).
+//
+// Returns:
+//   - The LRU cache, for chaining.
+//
+// Details:
+//   - Under sustained writes, evicting one entry per insert pays the eviction cost on every Set;
+//     evicting high-low entries at once amortizes it, at the price of holding fewer entries right after.
+//   - Only inserts that overflow the high watermark trigger a batch. Shrinking with SetCapacity or
+//     Resize evicts down to the new capacity exactly; a low watermark not below the new capacity stops batching.
+func (c *LRU) WithWatermarks(low, high int) *LRU {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.lowWatermark = max(low, 0)
+	c.resize(high, false)
+	return c
+}
+
+// Watermarks returns the low watermark and the high watermark, which is the capacity.
+//
+// Returns:
+//   - The low watermark, or zero if batch eviction is disabled.
+//   - The capacity.
+func (c *LRU) Watermarks() (low, high int) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	if low = c.watermark(); low == c.capacity {
+		low = 0
+	}
+	return low, c.capacity
+}
+
+// watermark returns the size an overflowing cache is evicted down to.
+//
+// Details:
+//   - Must be called with the read or write lock held.
+func (c *LRU) watermark() int {
+	if c.lowWatermark > 0 && c.lowWatermark < c.capacity {
+		return c.lowWatermark
+	}
+	return c.capacity
+}
@@ -0,0 +1,77 @@
+package cachify
+
+import (
+	"time"
+)
+
+// WithStatsWindow keeps sliding-window counters alongside the lifetime ones.
+//
+// Parameters:
+//   - interval: The length of one window bucket, e.g. time.Minute.
+//   - buckets: The number of buckets, so the window spans interval * buckets. Zero or less disables the window.
+//
+// Returns:
+//   - The LRU cache, for chaining.
+//
+// Details:
+//   - The window advances one bucket at a time: WindowStats covers the current, partial bucket
+//     plus the previous buckets-1 full ones.
+//   - Counts landing exactly as a bucket is recycled may be lost, so window counters are approximate.
+//   - Reconfiguring the window discards the counts recorded so far.
+func (c *LRU) WithStatsWindow(interval time.Duration, buckets int) *LRU {
+	if interval <= 0 || buckets <= 0 {
+		c.stats.window.Store(nil)
+		return c
+	}
+	c.stats.window.Store(&statsWindow{
+		width:   interval,
+		buckets: make([]windowBucket, buckets),
+	})
+	return c
+}
+
+// WindowStats returns the usage counters of the recent past.
+//
+// Returns:
+//   - The hits, misses, evictions, and expirations within the window (see WithStatsWindow), and the current length.
+//   - Zero counters if no window is configured.
+//
+// Details:
+//   - Unlike Stats, the hit ratio reflects recent traffic instead of being diluted by the cache's whole lifetime.
+func (c *LRU) WindowStats() Stats {
+	stats := Stats{Len: c.Len()}
+	w := c.stats.window.Load()
+	if w == nil {
+		return stats
+	}
+	oldest := w.epoch(time.Now()) - int64(len(w.buckets)) + 1
+	for i := range w.buckets {
+		bucket := &w.buckets[i]
+		if bucket.epoch.Load() < oldest {
+			continue
+		}
+		stats.Hits += bucket.hits.Load()
+		stats.Misses += bucket.misses.Load()
+		stats.Evictions += bucket.evictions.Load()
+		stats.Expirations += bucket.expirations.Load()
+	}
+	return stats
+}
+
+// epoch returns the interval a time falls into, counted from one.
+func (w *statsWindow) epoch(now time.Time) int64 {
+	return now.UnixNano()/int64(w.width) + 1
+}
+
+// current returns the bucket of the current interval, recycling it if it still holds an older interval.
+func (w *statsWindow) current() *windowBucket {
+	epoch := w.epoch(time.Now())
+	bucket := &w.buckets[epoch%int64(len(w.buckets))]
+	if old := bucket.epoch.Load(); old != epoch && bucket.epoch.CompareAndSwap(old, epoch) {
+		bucket.hits.Store(0)
+		bucket.misses.Store(0)
+		bucket.evictions.Store(0)
+		bucket.expirations.Store(0)
+	}
+	return bucket
+}
@@ -0,0 +1,218 @@
+package cachify
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// NewWriteBehind creates a write-behind decorator over an LRU cache.
+//
+// Parameters:
+//   - cache: The in-memory LRU cache serving reads and writes.
+//   - store: The backing store receiving flushed writes.
+//   - interval: How often dirty entries are flushed. Zero or less uses 1 second.
+//   - batchSize: The number of dirty keys that triggers an early flush. Zero or less disables early flushes.
+//
+// Returns:
+//   - A pointer to an initialized WriteBehind cache.
+//
+// Details:
+//   - Starts a background goroutine that flushes on every interval tick or when the batch size is reached.
+//   - Repeated writes to the same key between flushes are coalesced into a single store write.
+//   - Close must be called to stop the goroutine and drain remaining dirty entries.
+func NewWriteBehind(cache *LRU, store Store, interval time.Duration, batchSize int) *WriteBehind {
+	if interval <= 0 {
+		interval = defaultWriteBehindInterval
+	}
+	w := &WriteBehind{
+		cache:     cache,
+		store:     store,
+		batchSize: batchSize,
+		attempts:  3,
+		backoff:   100 * time.Millisecond,
+		dirty:     make(map[string]pending),
+		trigger:   make(chan struct{}, 1),
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+	go w.startFlusher(interval)
+	return w
+}
+
+// WithTTL sets the time-to-live used when writing entries to the backing store.
+//
+// Returns:
+//   - The WriteBehind cache, for chaining.
+func (w *WriteBehind) WithTTL(ttl time.Duration) *WriteBehind {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	w.ttl = ttl
+	return w
+}
+
+// WithRetry sets the retry policy for store operations.
+//
+// Parameters:
+//   - attempts: The total number of tries per operation. Values below 1 are treated as 1.
+//   - backoff: The initial delay between tries, doubled after each failure.
+//
+// Returns:
+//   - The WriteBehind cache, for chaining.
+func (w *WriteBehind) WithRetry(attempts int, backoff time.Duration) *WriteBehind {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if attempts < 1 {
+		attempts = 1
+	}
+	w.attempts = attempts
+	w.backoff = backoff
+	return w
+}
+
+// WithErrorCallback sets the callback invoked when a store operation still fails after all retries.
+//
+// Returns:
+//   - The WriteBehind cache, for chaining.
+func (w *WriteBehind) WithErrorCallback(callback OnErrorCallback) *WriteBehind {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	w.onError = callback
+	return w
+}
+
+// Cache returns the decorated in-memory cache.
+func (w *WriteBehind) Cache() *LRU {
+	return w.cache
+}
+
+// Get retrieves the value associated with a given key from the in-memory cache.
+func (w *WriteBehind) Get(key string) (value interface{}, ok bool) {
+	return w.cache.Get(key)
+}
+
+// Set inserts or updates a key-value pair in the cache and marks it dirty.
+func (w *WriteBehind) Set(key string, value interface{}) {
+	w.cache.Set(key, value)
+	w.markDirty(key, pending{value: value})
+}
+
+// Remove deletes a key from the cache and schedules its deletion from the backing store.
+func (w *WriteBehind) Remove(key string) {
+	w.cache.Remove(key)
+	w.markDirty(key, pending{deleted: true})
+}
+
+// Pending returns the number of dirty keys waiting to be flushed.
+func (w *WriteBehind) Pending() int {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	return len(w.dirty)
+}
+
+// Flush writes all dirty entries to the backing store immediately.
+//
+// Parameters:
+//   - ctx: The context passed to the backing store.
+//
+// Returns:
+//   - The joined errors of operations that still failed after all retries, or nil.
+//
+// Details:
+//   - Operations that still fail after all retries are reported to the error callback and dropped.
+func (w *WriteBehind) Flush(ctx context.Context) error {
+	w.flushMutex.Lock()
+	defer w.flushMutex.Unlock()
+
+	w.mutex.Lock()
+	batch := w.dirty
+	w.dirty = make(map[string]pending)
+	ttl, attempts, backoff, onError := w.ttl, w.attempts, w.backoff, w.onError
+	w.mutex.Unlock()
+
+	if ttl <= 0 {
+		ttl = w.cache.expiry()
+	}
+	var errs []error
+	for key, op := range batch {
+		err := w.retry(ctx, attempts, backoff, func() error {
+			if op.deleted {
+				return w.store.Delete(ctx, key)
+			}
+			return w.store.Set(ctx, key, op.value, ttl)
+		})
+		if err != nil {
+			errs = append(errs, err)
+			if onError != nil {
+				onError(key, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Close stops the background flusher and drains all remaining dirty entries.
+//
+// Returns:
+//   - The error of the final flush, or nil.
+//
+// Details:
+//   - Safe to call more than once; subsequent calls only flush what was written since.
+func (w *WriteBehind) Close() error {
+	w.closeOnce.Do(func() {
+		close(w.stop)
+		<-w.done
+	})
+	return w.Flush(context.Background())
+}
+
+// markDirty records a pending operation and requests an early flush when the batch is full.
+func (w *WriteBehind) markDirty(key string, op pending) {
+	w.mutex.Lock()
+	w.dirty[key] = op
+	full := w.batchSize > 0 && len(w.dirty) >= w.batchSize
+	w.mutex.Unlock()
+	if full {
+		select {
+		case w.trigger <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// retry runs an operation until it succeeds, the attempts are exhausted, or the context is done.
+func (w *WriteBehind) retry(ctx context.Context, attempts int, backoff time.Duration, op func() error) error {
+	var err error
+	for i := 0; i < attempts; i++ {
+		if err = op(); err == nil {
+			return nil
+		}
+		if i == attempts-1 {
+			break
+		}
+		select {
+		case <-time.After(backoff):
+			backoff *= 2
+		case <-ctx.Done():
+			return errors.Join(err, ctx.Err())
+		}
+	}
+	return err
+}
+
+// startFlusher periodically flushes dirty entries until Close is called.
+func (w *WriteBehind) startFlusher(interval time.Duration) {
+	defer close(w.done)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			_ = w.Flush(context.Background())
+		case <-w.trigger:
+			_ = w.Flush(context.Background())
+		case <-w.stop:
+			return
+		}
+	}
+}
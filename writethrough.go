@@ -0,0 +1,149 @@
+package cachify
+
+import (
+	"context"
+	"time"
+)
+
+// NewWriteThrough creates a write-through decorator over an LRU cache.
+//
+// Parameters:
+//   - cache: The in-memory LRU cache serving reads.
+//   - store: The backing store receiving every write and removal.
+//
+// Returns:
+//   - A pointer to an initialized WriteThrough cache.
+//
+// Details:
+//   - Writes reach the store first; the in-memory cache is only updated once the store accepts the value,
+//     so a failing store never leaves L1 ahead of the durable tier.
+func NewWriteThrough(cache *LRU, store Store) *WriteThrough {
+	return &WriteThrough{
+		cache: cache,
+		store: store,
+	}
+}
+
+// WithTTL sets the time-to-live used when writing entries to the backing store.
+//
+// Parameters:
+//   - ttl: The duration after which store entries expire. Zero falls back to the cache expiration.
+//
+// Returns:
+//   - The WriteThrough cache, for chaining.
+func (w *WriteThrough) WithTTL(ttl time.Duration) *WriteThrough {
+	w.ttl = ttl
+	return w
+}
+
+// WithErrorCallback sets the callback invoked when a store operation fails
+// inside a method that does not return an error (Set, Update, Remove).
+//
+// Returns:
+//   - The WriteThrough cache, for chaining.
+func (w *WriteThrough) WithErrorCallback(callback OnErrorCallback) *WriteThrough {
+	w.onError = callback
+	return w
+}
+
+// Cache returns the decorated in-memory cache.
+func (w *WriteThrough) Cache() *LRU {
+	return w.cache
+}
+
+// Get retrieves the value associated with a given key from the in-memory cache.
+//
+// Returns:
+//   - The value associated with the key, or nil if the key is not found.
+//   - A boolean indicating whether the key exists.
+func (w *WriteThrough) Get(key string) (value interface{}, ok bool) {
+	return w.cache.Get(key)
+}
+
+// Set writes a key-value pair to the backing store and then to the cache.
+//
+// Details:
+//   - Store errors are reported to the error callback and the cache is left untouched.
+func (w *WriteThrough) Set(key string, value interface{}) {
+	if err := w.SetContext(context.Background(), key, value); err != nil {
+		w.notify(key, err)
+	}
+}
+
+// SetContext writes a key-value pair to the backing store and then to the cache.
+//
+// Parameters:
+//   - ctx: The context passed to the backing store.
+//   - key: The key to be added or updated.
+//   - value: The value to be associated with the key.
+//
+// Returns:
+//   - An error if the store write failed, in which case the cache is not updated.
+func (w *WriteThrough) SetContext(ctx context.Context, key string, value interface{}) error {
+	if err := w.store.Set(ctx, key, value, w.storeTTL()); err != nil {
+		return err
+	}
+	w.cache.Set(key, value)
+	return nil
+}
+
+// Update writes a new value for an existing key to the backing store and then to the cache.
+//
+// Details:
+//   - Does nothing if the key is not present in the cache.
+//   - Store errors are reported to the error callback.
+func (w *WriteThrough) Update(key string, value interface{}) {
+	if err := w.UpdateContext(context.Background(), key, value); err != nil {
+		w.notify(key, err)
+	}
+}
+
+// UpdateContext writes a new value for an existing key to the backing store and then to the cache.
+//
+// Returns:
+//   - An error if the store write failed, in which case the cache is not updated.
+func (w *WriteThrough) UpdateContext(ctx context.Context, key string, value interface{}) error {
+	if !w.cache.Contains(key) {
+		return nil
+	}
+	if err := w.store.Set(ctx, key, value, w.storeTTL()); err != nil {
+		return err
+	}
+	w.cache.Update(key, value)
+	return nil
+}
+
+// Remove deletes a key from the cache and the backing store.
+//
+// Details:
+//   - Store errors are reported to the error callback.
+func (w *WriteThrough) Remove(key string) {
+	if err := w.RemoveContext(context.Background(), key); err != nil {
+		w.notify(key, err)
+	}
+}
+
+// RemoveContext deletes a key from the cache and the backing store.
+//
+// Returns:
+//   - An error if the store delete failed. The key is removed from the cache regardless,
+//     so a stale value is never served from memory.
+func (w *WriteThrough) RemoveContext(ctx context.Context, key string) error {
+	w.cache.Remove(key)
+	return w.store.Delete(ctx, key)
+}
+
+// storeTTL returns the time-to-live used for store writes.
+func (w *WriteThrough) storeTTL() time.Duration {
+	if w.ttl > 0 {
+		return w.ttl
+	}
+	return w.cache.expiry()
+}
+
+// notify invokes the error callback, if any.
+func (w *WriteThrough) notify(key string, err error) {
+	if w.onError != nil {
+		w.onError(key, err)
+	}
+}
@@ -0,0 +1,79 @@
+package cachify
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// WithEarlyExpiration enables probabilistic early expiration (XFetch) to prevent cache stampedes.
+//
+// Parameters:
+//   - beta: The eagerness of early reloads. 1 is the recommended value, larger values reload earlier,
+//     and zero or less disables early expiration.
+//
+// Returns:
+//   - The Loading cache, for chaining.
+//
+// Details:
+//   - Without it, every caller misses at the same instant when a hot entry expires, and they all wait
+//     for the reload. With it, each Get on an entry nearing its expiry treats it as expired with a
+//     probability that rises as the expiry approaches, so one caller usually reloads it ahead of time
+//     while the others keep being served the cached value.
+//   - A hit is reloaded when now - delta * beta * ln(rand()) >= expiry, where delta is how long the
+//     Loader took to produce the entry, so slow loads start earlier.
+//   - Only entries with an expiration that were produced by this Loading cache's Loader are affected.
+//     The early reload shares in-flight calls like any miss; should it fail, the cached value is
+//     returned without an error, since it has not expired yet.
+func (l *Loading) WithEarlyExpiration(beta float64) *Loading {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.beta = max(beta, 0)
+	return l
+}
+
+// expiresEarly reports whether a cached key should be reloaded ahead of its expiry.
+func (l *Loading) expiresEarly(key string) bool {
+	l.mutex.Lock()
+	beta := l.beta
+	l.mutex.Unlock()
+	if beta == 0 {
+		return false
+	}
+	expiration, delta, ok := l.cache.loadedState(key)
+	if !ok || expiration.IsZero() || delta <= 0 {
+		return false
+	}
+	// 1 - Float64 lies in (0, 1], keeping the logarithm finite. The gap stays a float64: with a large
+	// beta it can exceed the range of a time.Duration
+	gap := -float64(delta) * beta * math.Log(1-rand.Float64())
+	return gap >= float64(time.Until(expiration))
+}
+
+// setLoaded stores a value produced by a Loader along with the time it took to compute.
+func (c *LRU) setLoaded(key string, value interface{}, delta time.Duration) {
+	if l := c.latency.Load(); l != nil {
+		defer l.set.observe(time.Now())
+	}
+	key = c.normalizeKey(key)
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if entry := c.set(key, value); entry != nil {
+		entry.delta = delta
+	}
+	c.enforceQuotas(key)
+	c.enforceCapacity(c.cache[key])
+}
+
+// loadedState returns the expiration of a key and the time its Loader took to compute it.
+func (c *LRU) loadedState(key string) (expiration time.Time, delta time.Duration, ok bool) {
+	key = c.normalizeKey(key)
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	element, exists := c.cache[key]
+	if !exists {
+		return time.Time{}, 0, false
+	}
+	entry := element.Value.(*entries)
+	return entry.expiration, entry.delta, true
+}